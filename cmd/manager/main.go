@@ -24,10 +24,14 @@ import (
 	sdkVersion "github.com/operator-framework/operator-sdk/version"
 	"github.com/redhat-cop/quay-operator/pkg/apis"
 	"github.com/redhat-cop/quay-operator/pkg/controller"
+	"github.com/redhat-cop/quay-operator/pkg/k8sutils"
+	"github.com/redhat-cop/quay-operator/pkg/webhook"
 	"github.com/redhat-cop/quay-operator/version"
 	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -39,7 +43,12 @@ var (
 	metricsHost               = "0.0.0.0"
 	metricsPort         int32 = 8383
 	operatorMetricsPort int32 = 8686
+	webhookPort               = 9443
 )
+
+// enableWebhooksEnvVar is the environment variable which enables the admission webhooks
+const enableWebhooksEnvVar = "ENABLE_WEBHOOKS"
+
 var log = logf.Log.WithName("cmd")
 
 func printVersion() {
@@ -72,12 +81,14 @@ func main() {
 
 	printVersion()
 
-	namespace, err := k8sutil.GetWatchNamespace()
+	watchNamespace, err := k8sutil.GetWatchNamespace()
 	if err != nil {
 		log.Error(err, "Failed to get watch namespace")
 		os.Exit(1)
 	}
 
+	namespaces := k8sutils.ParseWatchNamespaces(watchNamespace)
+
 	// Get a config to talk to the apiserver
 	cfg, err := config.GetConfig()
 	if err != nil {
@@ -98,11 +109,24 @@ func main() {
 	mapperProvider := func(c *rest.Config) (meta.RESTMapper, error) {
 		return apiutil.NewDynamicRESTMapper(cfg)
 	}
-	mgr, err := manager.New(cfg, manager.Options{
-		Namespace:          namespace,
+	options := manager.Options{
 		MapperProvider:     mapperProvider,
 		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
-	})
+		Port:               webhookPort,
+	}
+
+	switch len(namespaces) {
+	case 0:
+		log.Info("Watching all namespaces")
+	case 1:
+		log.Info("Watching namespace", "Namespace", namespaces[0])
+		options.Namespace = namespaces[0]
+	default:
+		log.Info("Watching namespaces", "Namespaces", namespaces)
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+	}
+
+	mgr, err := manager.New(cfg, options)
 	if err != nil {
 		log.Error(err, "")
 		os.Exit(1)
@@ -133,7 +157,15 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = serveCRMetrics(cfg); err != nil {
+	// Setup all Webhooks
+	if os.Getenv(enableWebhooksEnvVar) == "true" {
+		if err := webhook.AddToManager(mgr); err != nil {
+			log.Error(err, "")
+			os.Exit(1)
+		}
+	}
+
+	if err = serveCRMetrics(cfg, namespaces); err != nil {
 		log.Info("Could not generate and serve custom resource metrics", "error", err.Error())
 	}
 
@@ -150,14 +182,16 @@ func main() {
 
 	// CreateServiceMonitors will automatically create the prometheus-operator ServiceMonitor resources
 	// necessary to configure Prometheus to scrape metrics from this operator.
-	services := []*corev1.Service{service}
-	_, err = metrics.CreateServiceMonitors(cfg, namespace, services)
-	if err != nil {
-		log.Info("Could not create ServiceMonitor object", "error", err.Error())
-		// If this operator is deployed to a cluster without the prometheus-operator running, it will return
-		// ErrServiceMonitorNotPresent, which can be used to safely skip ServiceMonitor creation.
-		if err == metrics.ErrServiceMonitorNotPresent {
-			log.Info("Install prometheus-operator in your cluster to create ServiceMonitor objects", "error", err.Error())
+	if service != nil {
+		services := []*corev1.Service{service}
+		_, err = metrics.CreateServiceMonitors(cfg, service.Namespace, services)
+		if err != nil {
+			log.Info("Could not create ServiceMonitor object", "error", err.Error())
+			// If this operator is deployed to a cluster without the prometheus-operator running, it will return
+			// ErrServiceMonitorNotPresent, which can be used to safely skip ServiceMonitor creation.
+			if err == metrics.ErrServiceMonitorNotPresent {
+				log.Info("Install prometheus-operator in your cluster to create ServiceMonitor objects", "error", err.Error())
+			}
 		}
 	}
 
@@ -172,20 +206,18 @@ func main() {
 
 // serveCRMetrics gets the Operator/CustomResource GVKs and generates metrics based on those types.
 // It serves those metrics on "http://metricsHost:operatorMetricsPort".
-func serveCRMetrics(cfg *rest.Config) error {
+// Metrics are generated for each of the watched namespaces, or for all namespaces when none are specified.
+func serveCRMetrics(cfg *rest.Config, namespaces []string) error {
 	// Below function returns filtered operator/CustomResource specific GVKs.
 	// For more control override the below GVK list with your own custom logic.
 	filteredGVK, err := k8sutil.GetGVKsFromAddToScheme(apis.AddToScheme)
 	if err != nil {
 		return err
 	}
-	// Get the namespace the operator is currently deployed in.
-	operatorNs, err := k8sutil.GetOperatorNamespace()
-	if err != nil {
-		return err
+	ns := namespaces
+	if len(ns) == 0 {
+		ns = []string{metav1.NamespaceAll}
 	}
-	// To generate metrics in other namespaces, add the values below.
-	ns := []string{operatorNs}
 	// Generate and serve custom resource specific metrics.
 	err = kubemetrics.GenerateAndServeCRMetrics(cfg, ns, filteredGVK, metricsHost, operatorMetricsPort)
 	if err != nil {
@@ -0,0 +1,4 @@
+package v1alpha1
+
+// Hub marks v1alpha1 as the version that all other QuayEcosystem versions convert to and from
+func (*QuayEcosystem) Hub() {}
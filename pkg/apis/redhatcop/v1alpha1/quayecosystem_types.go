@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"encoding/json"
+	"reflect"
 	"time"
 
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
@@ -10,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // QuayEcosystemSpec defines the desired state of QuayEcosystem
@@ -26,6 +29,9 @@ type QuayEcosystemPhase string
 // QuayEcosystemConditionType defines the types of conditions the operator will run through
 type QuayEcosystemConditionType string
 
+// QuayEcosystemConditionReason defines the reason a condition is in its current state
+type QuayEcosystemConditionReason string
+
 // ConfigFileType defines the type of configuration file
 type ConfigFileType string
 
@@ -37,38 +43,95 @@ type TLSTerminationType string
 
 const (
 
+	// QuayEcosystemAvailableCondition indicates that Quay is deployed and serving requests
+	QuayEcosystemAvailableCondition QuayEcosystemConditionType = "Available"
+
+	// QuayEcosystemProgressingCondition indicates that the operator is actively rolling out changes
+	QuayEcosystemProgressingCondition QuayEcosystemConditionType = "Progressing"
+
+	// QuayEcosystemDegradedCondition indicates that the last reconciliation failed
+	QuayEcosystemDegradedCondition QuayEcosystemConditionType = "Degraded"
+
+	// QuayEcosystemSetupCompleteCondition indicates that the initial Quay setup process has completed
+	QuayEcosystemSetupCompleteCondition QuayEcosystemConditionType = "SetupComplete"
+
+	// QuayEcosystemRegistryFunctionalCondition indicates that the last registry smoke test pushed, pulled and deleted an image successfully
+	QuayEcosystemRegistryFunctionalCondition QuayEcosystemConditionType = "RegistryFunctional"
+
 	// QuayEcosystemValidationFailure indicates that there was an error validating the configuration
-	QuayEcosystemValidationFailure QuayEcosystemConditionType = "QuayEcosystemValidationFailure"
+	QuayEcosystemValidationFailure QuayEcosystemConditionReason = "ValidationFailure"
 
 	// QuayEcosystemUpdateDefaultConfigurationConditionSuccess represents successfully updating of the default spec configuration
-	QuayEcosystemUpdateDefaultConfigurationConditionSuccess QuayEcosystemConditionType = "UpdateDefaultConfigurationSuccess"
+	QuayEcosystemUpdateDefaultConfigurationConditionSuccess QuayEcosystemConditionReason = "UpdateDefaultConfigurationSuccess"
 
 	// QuayEcosystemUpdateDefaultConfigurationConditionFailure represents failing to updating of the default spec configuration
-	QuayEcosystemUpdateDefaultConfigurationConditionFailure QuayEcosystemConditionType = "UpdateDefaultConfigurationFailure"
+	QuayEcosystemUpdateDefaultConfigurationConditionFailure QuayEcosystemConditionReason = "UpdateDefaultConfigurationFailure"
 
 	// QuayEcosystemProvisioningSuccess indicates that the QuayEcosystem provisioning was successful
-	QuayEcosystemProvisioningSuccess QuayEcosystemConditionType = "QuayEcosystemProvisioningSuccess"
+	QuayEcosystemProvisioningSuccess QuayEcosystemConditionReason = "ProvisioningSuccess"
 
 	// QuayEcosystemProvisioningFailure indicates that the QuayEcosystem provisioning failed
-	QuayEcosystemProvisioningFailure QuayEcosystemConditionType = "QuayEcosystemProvisioningFailure"
+	QuayEcosystemProvisioningFailure QuayEcosystemConditionReason = "ProvisioningFailure"
 
 	// QuayEcosystemCleanupFailure indicates that the QuayEcosystem provisioning failed to cleanup resources
-	QuayEcosystemCleanupFailure QuayEcosystemConditionType = "QuayEcosystemCleanupFailure"
+	QuayEcosystemCleanupFailure QuayEcosystemConditionReason = "CleanupFailure"
 
 	// QuayEcosystemQuaySetupSuccess indicates that the Quay setup process was successful
-	QuayEcosystemQuaySetupSuccess QuayEcosystemConditionType = "QuaySetupSuccess"
+	QuayEcosystemQuaySetupSuccess QuayEcosystemConditionReason = "QuaySetupSuccess"
+
 	// QuayEcosystemQuaySetupFailure indicates that the Quay setup process failed
-	QuayEcosystemQuaySetupFailure QuayEcosystemConditionType = "QuaySetupFailure"
+	QuayEcosystemQuaySetupFailure QuayEcosystemConditionReason = "QuaySetupFailure"
+
+	// QuayEcosystemQuaySetupPending indicates that the Quay setup process has not yet been run
+	QuayEcosystemQuaySetupPending QuayEcosystemConditionReason = "QuaySetupPending"
+
+	// QuayEcosystemQuaySetupSkipped indicates that the Quay setup process has been skipped
+	QuayEcosystemQuaySetupSkipped QuayEcosystemConditionReason = "QuaySetupSkipped"
 
 	// QuayEcosystemClairConfigurationSuccess indicates that the Clair configuration process succeeded
-	QuayEcosystemClairConfigurationSuccess QuayEcosystemConditionType = "QuayEcosystemClairConfigurationSuccess"
+	QuayEcosystemClairConfigurationSuccess QuayEcosystemConditionReason = "ClairConfigurationSuccess"
+
 	// QuayEcosystemClairConfigurationFailure indicates that the Clair configuration process failed
-	QuayEcosystemClairConfigurationFailure QuayEcosystemConditionType = "QuayEcosystemClairConfigurationFailure"
+	QuayEcosystemClairConfigurationFailure QuayEcosystemConditionReason = "ClairConfigurationFailure"
 
 	// QuayEcosystemSecurityScannerConfigurationSuccess indicates that the security scanner was configured successfully
-	QuayEcosystemSecurityScannerConfigurationSuccess QuayEcosystemConditionType = "QuayEcosystemSecurityScannerConfigurationSuccess"
+	QuayEcosystemSecurityScannerConfigurationSuccess QuayEcosystemConditionReason = "SecurityScannerConfigurationSuccess"
+
 	// QuayEcosystemSecurityScannerConfigurationFailure indicates that the security scanner configuration failed
-	QuayEcosystemSecurityScannerConfigurationFailure QuayEcosystemConditionType = "QuayEcosystemSecurityScannerConfigurationFailure"
+	QuayEcosystemSecurityScannerConfigurationFailure QuayEcosystemConditionReason = "SecurityScannerConfigurationFailure"
+
+	// QuayEcosystemAPITokenSuccess indicates that the OAuth token for the Quay API was minted successfully
+	QuayEcosystemAPITokenSuccess QuayEcosystemConditionReason = "APITokenSuccess"
+
+	// QuayEcosystemAPITokenFailure indicates that the OAuth token for the Quay API could not be minted
+	QuayEcosystemAPITokenFailure QuayEcosystemConditionReason = "APITokenFailure"
+
+	// QuayEcosystemRegistrySmokeTestSuccess indicates that the registry smoke test succeeded
+	QuayEcosystemRegistrySmokeTestSuccess QuayEcosystemConditionReason = "RegistrySmokeTestSuccess"
+
+	// QuayEcosystemRegistrySmokeTestFailure indicates that the registry smoke test failed
+	QuayEcosystemRegistrySmokeTestFailure QuayEcosystemConditionReason = "RegistrySmokeTestFailure"
+
+	// QuayEcosystemRegistrySmokeTestPending indicates that the registry smoke test is waiting for Quay to become available or for its first run to complete
+	QuayEcosystemRegistrySmokeTestPending QuayEcosystemConditionReason = "RegistrySmokeTestPending"
+
+	// QuayEcosystemReconcileComplete indicates that all resources have been reconciled
+	QuayEcosystemReconcileComplete QuayEcosystemConditionReason = "ReconcileComplete"
+
+	// QuayEcosystemQuayAvailable indicates that all desired replicas of Quay are ready
+	QuayEcosystemQuayAvailable QuayEcosystemConditionReason = "QuayAvailable"
+
+	// QuayEcosystemQuayUnavailable indicates that not all desired replicas of Quay are ready
+	QuayEcosystemQuayUnavailable QuayEcosystemConditionReason = "QuayUnavailable"
+
+	// QuayEcosystemPhaseProvisioning indicates that the QuayEcosystem is being provisioned
+	QuayEcosystemPhaseProvisioning QuayEcosystemPhase = "Provisioning"
+
+	// QuayEcosystemPhaseRunning indicates that the QuayEcosystem is available
+	QuayEcosystemPhaseRunning QuayEcosystemPhase = "Running"
+
+	// QuayEcosystemPhaseFailed indicates that the QuayEcosystem failed to reconcile
+	QuayEcosystemPhaseFailed QuayEcosystemPhase = "Failed"
 
 	// ExtraCaCertConfigFileType specifies a Extra Ca Certificate file type
 	ExtraCaCertConfigFileType ConfigFileType = "extraCaCert"
@@ -113,6 +176,81 @@ type QuayEcosystemStatus struct {
 	// +listType=atomic
 	Conditions    []QuayEcosystemCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
 	SetupComplete bool                     `json:"setupComplete,omitempty"`
+	// ObservedGeneration is the most recent generation of the specification processed by the operator
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// EffectiveSpec is the QuayEcosystemSpec after defaults have been applied by the operator
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	EffectiveSpec *runtime.RawExtension `json:"effectiveSpec,omitempty"`
+	// Components reports the state of each component managed by the operator
+	// +optional
+	Components *QuayEcosystemComponentsStatus `json:"components,omitempty"`
+	// Replicas is the number of ready Quay application pods and is reported by the scale subresource
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// LabelSelector selects the Quay application pods and is used by the scale subresource
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// APIToken reports the OAuth token for the Quay API minted by the operator
+	// +optional
+	APIToken *APITokenStatus `json:"apiToken,omitempty"`
+	// SmokeTest reports the last registry smoke test run by the operator
+	// +optional
+	SmokeTest *RegistrySmokeTestStatus `json:"smokeTest,omitempty"`
+}
+
+// RegistrySmokeTestStatus defines the observed state of the registry smoke test
+// +k8s:openapi-gen=true
+type RegistrySmokeTestStatus struct {
+	// LastRunTime is the time the smoke test last ran
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+	// ObservedGeneration is the generation of the QuayEcosystem the smoke test last ran for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// APITokenStatus defines the observed state of the OAuth token for the Quay API minted by the operator
+// +k8s:openapi-gen=true
+type APITokenStatus struct {
+	// SecretName is the name of the Secret containing the token
+	SecretName string `json:"secretName"`
+	// Organization is the organization containing the OAuth application the token was issued for
+	Organization string `json:"organization"`
+	// ClientID is the client ID of the OAuth application the token was issued for
+	ClientID string `json:"clientID"`
+	// Scopes are the permissions granted to the token
+	// +listType=set
+	Scopes []QuayAPITokenScope `json:"scopes,omitempty"`
+	// RegenerationRequest is the value of the token regeneration annotation last processed by the operator
+	// +optional
+	RegenerationRequest string `json:"regenerationRequest,omitempty"`
+	// CreationTime is the time the token was minted
+	// +optional
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+}
+
+// QuayEcosystemComponentsStatus defines the observed state of each component of the QuayEcosystem
+// +k8s:openapi-gen=true
+type QuayEcosystemComponentsStatus struct {
+	Quay          *ComponentStatus `json:"quay,omitempty"`
+	QuayConfig    *ComponentStatus `json:"quayConfig,omitempty"`
+	RepoMirror    *ComponentStatus `json:"repoMirror,omitempty"`
+	Redis         *ComponentStatus `json:"redis,omitempty"`
+	QuayDatabase  *ComponentStatus `json:"quayDatabase,omitempty"`
+	Clair         *ComponentStatus `json:"clair,omitempty"`
+	ClairDatabase *ComponentStatus `json:"clairDatabase,omitempty"`
+}
+
+// ComponentStatus defines the observed state of a component deployed by the operator
+// +k8s:openapi-gen=true
+type ComponentStatus struct {
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	ReadyReplicas   int32  `json:"readyReplicas"`
+	Image           string `json:"image,omitempty"`
+	Endpoint        string `json:"endpoint,omitempty"`
+	LastError       string `json:"lastError,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -121,6 +259,12 @@ type QuayEcosystemStatus struct {
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=quayecosystems,scope=Namespaced
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:scale:specpath=.spec.quay.replicas,statuspath=.status.replicas,selectorpath=.status.labelSelector
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Lifecycle phase of the QuayEcosystem"
+// +kubebuilder:printcolumn:name="Hostname",type="string",JSONPath=".status.hostname",description="Hostname of Quay"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type==\"Available\")].status",description="Whether Quay is available"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type QuayEcosystem struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -190,8 +334,45 @@ type Quay struct {
 	// +listType=set
 	Superusers []string `json:"superusers,omitempty"`
 
+	HorizontalPodAutoscaler           *HorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+	RepoMirrorHorizontalPodAutoscaler *HorizontalPodAutoscaler `json:"repoMirrorHorizontalPodAutoscaler,omitempty"`
+
 	// +listType=set
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+
+	// APIToken requests an OAuth token for the Quay API to be minted by the operator once setup has completed
+	// +optional
+	APIToken *APIToken `json:"apiToken,omitempty"`
+
+	// SmokeTest requests the registry to be verified periodically by pushing, pulling and deleting an image once setup has completed
+	// +optional
+	SmokeTest *RegistrySmokeTest `json:"smokeTest,omitempty"`
+}
+
+// RegistrySmokeTest defines the smoke test verifying the registry on behalf of the initial superuser
+// +k8s:openapi-gen=true
+type RegistrySmokeTest struct {
+	// Organization is the organization dedicated to the smoke test which is created when it does not exist. Defaults to quay-operator-smoke-test
+	// +optional
+	Organization string `json:"organization,omitempty"`
+	// Interval is the duration between runs of the smoke test, for example 30m. Defaults to 1h
+	// +optional
+	Interval string `json:"interval,omitempty"`
+}
+
+// APIToken defines the OAuth token for the Quay API minted by the operator on behalf of the initial superuser
+// +k8s:openapi-gen=true
+type APIToken struct {
+	// Organization is the organization administered by the initial superuser that contains the OAuth application of the operator. Defaults to quay-operator
+	// +optional
+	Organization string `json:"organization,omitempty"`
+	// Scopes are the permissions granted to the token. Defaults to all scopes
+	// +optional
+	// +listType=set
+	Scopes []QuayAPITokenScope `json:"scopes,omitempty"`
+	// SecretName is the name of the Secret the token is stored in. Defaults to <name>-quay-api-token
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
 }
 
 // QuayEcosystemCondition defines a list of conditions that the object will transiton through
@@ -281,7 +462,20 @@ type Clair struct {
 	// +listType=atomic
 	ConfigFiles []ConfigFiles `json:"configFiles,omitempty" patchStrategy:"merge" patchMergeKey:"secretName" protobuf:"bytes,2,rep,name=configFiles"`
 	// +listType=set
-	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	Tolerations             []corev1.Toleration      `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	HorizontalPodAutoscaler *HorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+}
+
+// HorizontalPodAutoscaler defines a HorizontalPodAutoscaler that scales a component based on CPU utilization
+// When specified, the operator no longer manages the number of replicas of the component
+// +k8s:openapi-gen=true
+type HorizontalPodAutoscaler struct {
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
 }
 
 // RegistryBackend defines a particular backend supporting the Quay registry
@@ -335,6 +529,10 @@ type TLSExternalAccess struct {
 	SecretName string `json:"secretName,omitempty"`
 	// termination indicates termination type.
 	Termination TLSTerminationType `json:"termination" protobuf:"bytes,1,opt,name=termination,casttype=TLSTerminationType"`
+	// InsecureSkipVerify disables the verification of the certificates presented by Quay and its config app to the operator
+	// Certificates are otherwise verified against the system roots, the certificates of Quay and Clair and the extraCaCert config files
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // LocalRegistryBackendSource defines local registry storage
@@ -445,6 +643,41 @@ type ConfigFile struct {
 	SecretContent []byte `json:"secretContent,omitempty,name=secretContent"`
 }
 
+// QuayAPITokenScope defines a permission granted to an OAuth token for the Quay API
+// +kubebuilder:validation:Enum="repo:read";"repo:write";"repo:admin";"repo:create";"user:read";"user:admin";"org:admin";"super:user"
+type QuayAPITokenScope string
+
+const (
+	// RepoReadQuayAPITokenScope grants read access to all repositories the user can view
+	RepoReadQuayAPITokenScope QuayAPITokenScope = "repo:read"
+	// RepoWriteQuayAPITokenScope grants write access to all repositories the user can write to
+	RepoWriteQuayAPITokenScope QuayAPITokenScope = "repo:write"
+	// RepoAdminQuayAPITokenScope grants administrative access to all repositories the user administers
+	RepoAdminQuayAPITokenScope QuayAPITokenScope = "repo:admin"
+	// RepoCreateQuayAPITokenScope grants the creation of repositories in namespaces the user can create repositories in
+	RepoCreateQuayAPITokenScope QuayAPITokenScope = "repo:create"
+	// UserReadQuayAPITokenScope grants read access to the information of the user
+	UserReadQuayAPITokenScope QuayAPITokenScope = "user:read"
+	// UserAdminQuayAPITokenScope grants administrative access to the user and its robot accounts
+	UserAdminQuayAPITokenScope QuayAPITokenScope = "user:admin"
+	// OrgAdminQuayAPITokenScope grants administrative access to the organizations the user administers
+	OrgAdminQuayAPITokenScope QuayAPITokenScope = "org:admin"
+	// SuperUserQuayAPITokenScope grants access to the superuser API
+	SuperUserQuayAPITokenScope QuayAPITokenScope = "super:user"
+)
+
+// AllQuayAPITokenScopes are the scopes granted to the token when none are specified
+var AllQuayAPITokenScopes = []QuayAPITokenScope{
+	RepoReadQuayAPITokenScope,
+	RepoWriteQuayAPITokenScope,
+	RepoAdminQuayAPITokenScope,
+	RepoCreateQuayAPITokenScope,
+	UserReadQuayAPITokenScope,
+	UserAdminQuayAPITokenScope,
+	OrgAdminQuayAPITokenScope,
+	SuperUserQuayAPITokenScope,
+}
+
 type QuayMigrationPhase string
 
 var (
@@ -493,18 +726,59 @@ func (q *QuayEcosystem) SetCondition(newCondition QuayEcosystemCondition) *QuayE
 		return &newCondition
 	}
 
-	if newCondition.Status != newCondition.Status {
+	if existingCondition.Status != newCondition.Status {
 		existingCondition.Status = newCondition.Status
 		existingCondition.LastTransitionTime = now
+		existingCondition.LastUpdateTime = now
+	}
+
+	if existingCondition.Reason != newCondition.Reason || existingCondition.Message != newCondition.Message {
+		existingCondition.Reason = newCondition.Reason
+		existingCondition.Message = newCondition.Message
+		existingCondition.LastUpdateTime = now
 	}
 
-	existingCondition.LastUpdateTime = now
-	existingCondition.Message = newCondition.Message
-	existingCondition.Reason = newCondition.Reason
 	return existingCondition
 
 }
 
+// RemoveUnknownConditions removes conditions which are not part of the standard condition set
+func (q *QuayEcosystem) RemoveUnknownConditions() {
+
+	conditions := []QuayEcosystemCondition{}
+
+	for _, condition := range q.Status.Conditions {
+		switch condition.Type {
+		case QuayEcosystemAvailableCondition, QuayEcosystemProgressingCondition, QuayEcosystemDegradedCondition, QuayEcosystemSetupCompleteCondition, QuayEcosystemRegistryFunctionalCondition:
+			conditions = append(conditions, condition)
+		}
+	}
+
+	q.Status.Conditions = conditions
+}
+
+// RemoveCondition removes the condition of the provided type
+func (q *QuayEcosystem) RemoveCondition(conditionType QuayEcosystemConditionType) {
+
+	conditions := []QuayEcosystemCondition{}
+
+	for _, condition := range q.Status.Conditions {
+		if condition.Type != conditionType {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	q.Status.Conditions = conditions
+}
+
+// IsConditionTrue determines whether the condition of the provided type has a status of True
+func (q *QuayEcosystem) IsConditionTrue(conditionType QuayEcosystemConditionType) bool {
+
+	condition, found := q.FindConditionByType(conditionType)
+
+	return found && condition.Status == corev1.ConditionTrue
+}
+
 // FindConditionByType locates the Condition by the type
 func (q *QuayEcosystem) FindConditionByType(conditionType QuayEcosystemConditionType) (*QuayEcosystemCondition, bool) {
 
@@ -517,6 +791,42 @@ func (q *QuayEcosystem) FindConditionByType(conditionType QuayEcosystemCondition
 	return &QuayEcosystemCondition{}, false
 }
 
+// GetEffectiveSpec returns the QuayEcosystemSpec published in the status
+func (q *QuayEcosystem) GetEffectiveSpec() (*QuayEcosystemSpec, error) {
+
+	if q.Status.EffectiveSpec == nil || len(q.Status.EffectiveSpec.Raw) == 0 {
+		return nil, nil
+	}
+
+	effectiveSpec := &QuayEcosystemSpec{}
+
+	if err := json.Unmarshal(q.Status.EffectiveSpec.Raw, effectiveSpec); err != nil {
+		return nil, err
+	}
+
+	return effectiveSpec, nil
+}
+
+// SetEffectiveSpec publishes the provided QuayEcosystemSpec in the status and returns whether it changed
+func (q *QuayEcosystem) SetEffectiveSpec(spec *QuayEcosystemSpec) (bool, error) {
+
+	existingSpec, err := q.GetEffectiveSpec()
+
+	if err == nil && reflect.DeepEqual(existingSpec, spec) {
+		return false, nil
+	}
+
+	raw, err := json.Marshal(spec)
+
+	if err != nil {
+		return false, err
+	}
+
+	q.Status.EffectiveSpec = &runtime.RawExtension{Raw: raw}
+
+	return true, nil
+}
+
 // GetQuayPort returns the port associated with Quay
 func (q *QuayEcosystem) GetQuayPort() int32 {
 	if q.IsInsecureQuay() {
@@ -535,3 +845,61 @@ func (q *QuayEcosystem) IsInsecureQuay() bool {
 	return false
 
 }
+
+// IsTLSVerificationSkipped determines whether the operator accepts any certificate presented by Quay and its config app
+func (q *QuayEcosystem) IsTLSVerificationSkipped() bool {
+	return q.Spec.Quay != nil && q.Spec.Quay.ExternalAccess != nil && q.Spec.Quay.ExternalAccess.TLS != nil && q.Spec.Quay.ExternalAccess.TLS.InsecureSkipVerify
+}
+
+// GetReferencedSecretNames returns the names of the Secrets referenced in the specification that provide configuration
+func (q *QuayEcosystem) GetReferencedSecretNames() []string {
+
+	secretNames := []string{}
+	found := map[string]bool{}
+
+	addSecretName := func(secretName string) {
+		if secretName != "" && !found[secretName] {
+			found[secretName] = true
+			secretNames = append(secretNames, secretName)
+		}
+	}
+
+	if q.Spec.Quay != nil {
+		addSecretName(q.Spec.Quay.ConfigSecretName)
+		addSecretName(q.Spec.Quay.SuperuserCredentialsSecretName)
+
+		if q.Spec.Quay.Database != nil {
+			addSecretName(q.Spec.Quay.Database.CredentialsSecretName)
+		}
+
+		for _, registryBackend := range q.Spec.Quay.RegistryBackends {
+			addSecretName(registryBackend.CredentialsSecretName)
+		}
+
+		if q.Spec.Quay.ExternalAccess != nil && q.Spec.Quay.ExternalAccess.TLS != nil {
+			addSecretName(q.Spec.Quay.ExternalAccess.TLS.SecretName)
+		}
+
+		for _, configFiles := range q.Spec.Quay.ConfigFiles {
+			addSecretName(configFiles.SecretName)
+		}
+	}
+
+	if q.Spec.Redis != nil {
+		addSecretName(q.Spec.Redis.CredentialsSecretName)
+	}
+
+	if q.Spec.Clair != nil {
+		addSecretName(q.Spec.Clair.SslCertificatesSecretName)
+
+		if q.Spec.Clair.Database != nil {
+			addSecretName(q.Spec.Clair.Database.CredentialsSecretName)
+		}
+
+		for _, configFiles := range q.Spec.Clair.ConfigFiles {
+			addSecretName(configFiles.SecretName)
+		}
+	}
+
+	return secretNames
+}
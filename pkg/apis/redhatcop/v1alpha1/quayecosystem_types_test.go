@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetConditionTransition(t *testing.T) {
+
+	quayEcosystem := &QuayEcosystem{}
+
+	quayEcosystem.SetCondition(QuayEcosystemCondition{
+		Type:   QuayEcosystemAvailableCondition,
+		Status: corev1.ConditionFalse,
+	})
+
+	// Simulate an older transition
+	past := metav1.Unix(0, 0)
+	quayEcosystem.Status.Conditions[0].LastTransitionTime = past
+	quayEcosystem.Status.Conditions[0].LastUpdateTime = past
+
+	// Same status should not change the transition time
+	quayEcosystem.SetCondition(QuayEcosystemCondition{
+		Type:   QuayEcosystemAvailableCondition,
+		Status: corev1.ConditionFalse,
+	})
+
+	condition, found := quayEcosystem.FindConditionByType(QuayEcosystemAvailableCondition)
+	assert.True(t, found)
+	assert.Equal(t, past, condition.LastTransitionTime)
+	assert.Equal(t, past, condition.LastUpdateTime)
+
+	// Different status should change the transition time
+	quayEcosystem.SetCondition(QuayEcosystemCondition{
+		Type:    QuayEcosystemAvailableCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  string(QuayEcosystemQuayAvailable),
+		Message: "1 of 1 Quay replicas are ready",
+	})
+
+	condition, found = quayEcosystem.FindConditionByType(QuayEcosystemAvailableCondition)
+	assert.True(t, found)
+	assert.Len(t, quayEcosystem.Status.Conditions, 1)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, string(QuayEcosystemQuayAvailable), condition.Reason)
+	assert.NotEqual(t, past, condition.LastTransitionTime)
+	assert.True(t, quayEcosystem.IsConditionTrue(QuayEcosystemAvailableCondition))
+}
+
+func TestRemoveUnknownConditions(t *testing.T) {
+
+	quayEcosystem := &QuayEcosystem{
+		Status: QuayEcosystemStatus{
+			Conditions: []QuayEcosystemCondition{
+				{
+					Type:   "QuayEcosystemProvisioningFailure",
+					Status: corev1.ConditionFalse,
+				},
+				{
+					Type:   QuayEcosystemDegradedCondition,
+					Status: corev1.ConditionFalse,
+				},
+			},
+		},
+	}
+
+	quayEcosystem.RemoveUnknownConditions()
+
+	assert.Len(t, quayEcosystem.Status.Conditions, 1)
+	assert.Equal(t, QuayEcosystemDegradedCondition, quayEcosystem.Status.Conditions[0].Type)
+}
+
+func TestGetReferencedSecretNames(t *testing.T) {
+
+	quayEcosystem := &QuayEcosystem{}
+	assert.Empty(t, quayEcosystem.GetReferencedSecretNames())
+
+	quayEcosystem.Spec = QuayEcosystemSpec{
+		Quay: &Quay{
+			SuperuserCredentialsSecretName: "quay-superuser",
+			Database: &Database{
+				CredentialsSecretName: "quay-database",
+			},
+			RegistryBackends: []RegistryBackend{
+				{Name: "s3", CredentialsSecretName: "registry-credentials"},
+				{Name: "azure", CredentialsSecretName: "registry-credentials"},
+			},
+			ExternalAccess: &ExternalAccess{
+				TLS: &TLSExternalAccess{SecretName: "quay-tls"},
+			},
+			ConfigFiles: []ConfigFiles{
+				{SecretName: "quay-config-files"},
+			},
+		},
+		Redis: &Redis{
+			CredentialsSecretName: "redis",
+		},
+		Clair: &Clair{
+			Database: &Database{
+				CredentialsSecretName: "clair-database",
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"quay-superuser", "quay-database", "registry-credentials", "quay-tls", "quay-config-files", "redis", "clair-database"}, quayEcosystem.GetReferencedSecretNames())
+}
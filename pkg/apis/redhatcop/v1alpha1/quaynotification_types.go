@@ -0,0 +1,214 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuayNotificationEvent defines the repository event triggering a notification
+// +kubebuilder:validation:Enum=repo_push;build_queued;build_start;build_success;build_failure;build_cancelled;vulnerability_found;repo_mirror_sync_started;repo_mirror_sync_success;repo_mirror_sync_failed
+type QuayNotificationEvent string
+
+const (
+	// RepoPushQuayNotificationEvent is triggered when images are pushed to the repository
+	RepoPushQuayNotificationEvent QuayNotificationEvent = "repo_push"
+
+	// BuildQueuedQuayNotificationEvent is triggered when a build of the repository is queued
+	BuildQueuedQuayNotificationEvent QuayNotificationEvent = "build_queued"
+
+	// BuildStartQuayNotificationEvent is triggered when a build of the repository starts
+	BuildStartQuayNotificationEvent QuayNotificationEvent = "build_start"
+
+	// BuildSuccessQuayNotificationEvent is triggered when a build of the repository succeeds
+	BuildSuccessQuayNotificationEvent QuayNotificationEvent = "build_success"
+
+	// BuildFailureQuayNotificationEvent is triggered when a build of the repository fails
+	BuildFailureQuayNotificationEvent QuayNotificationEvent = "build_failure"
+
+	// BuildCancelledQuayNotificationEvent is triggered when a build of the repository is cancelled
+	BuildCancelledQuayNotificationEvent QuayNotificationEvent = "build_cancelled"
+
+	// VulnerabilityFoundQuayNotificationEvent is triggered when a vulnerability is found in an image of the repository
+	VulnerabilityFoundQuayNotificationEvent QuayNotificationEvent = "vulnerability_found"
+
+	// RepoMirrorSyncStartedQuayNotificationEvent is triggered when the synchronization of a mirrored repository starts
+	RepoMirrorSyncStartedQuayNotificationEvent QuayNotificationEvent = "repo_mirror_sync_started"
+
+	// RepoMirrorSyncSuccessQuayNotificationEvent is triggered when the synchronization of a mirrored repository succeeds
+	RepoMirrorSyncSuccessQuayNotificationEvent QuayNotificationEvent = "repo_mirror_sync_success"
+
+	// RepoMirrorSyncFailedQuayNotificationEvent is triggered when the synchronization of a mirrored repository fails
+	RepoMirrorSyncFailedQuayNotificationEvent QuayNotificationEvent = "repo_mirror_sync_failed"
+)
+
+// QuayNotificationMethod defines how a notification is delivered
+// +kubebuilder:validation:Enum=webhook;email;slack
+type QuayNotificationMethod string
+
+const (
+	// WebhookQuayNotificationMethod delivers notifications as a POST request to a URL
+	WebhookQuayNotificationMethod QuayNotificationMethod = "webhook"
+
+	// EmailQuayNotificationMethod delivers notifications by email
+	EmailQuayNotificationMethod QuayNotificationMethod = "email"
+
+	// SlackQuayNotificationMethod delivers notifications to a Slack incoming webhook
+	SlackQuayNotificationMethod QuayNotificationMethod = "slack"
+)
+
+// QuayNotificationSpec defines the desired state of QuayNotification
+// The configuration matching the method must be specified
+// +k8s:openapi-gen=true
+type QuayNotificationSpec struct {
+	QuayResourceSpec `json:",inline"`
+	// Namespace is the organization or user in Quay containing the repository
+	Namespace string `json:"namespace"`
+	// Repository is the name of the repository whose events are notified
+	Repository string `json:"repository"`
+	// Title is the title identifying the notification in Quay. An existing notification with the same title is replaced. Defaults to the name of the resource
+	// +optional
+	Title string `json:"title,omitempty"`
+	// Event is the repository event triggering the notification
+	Event QuayNotificationEvent `json:"event"`
+	// EventConfig filters the events triggering the notification such as the minimum level of vulnerability_found events or the ref-regex of build events
+	// +optional
+	EventConfig map[string]string `json:"eventConfig,omitempty"`
+	// Method is how the notification is delivered
+	Method QuayNotificationMethod `json:"method"`
+	// Webhook configures the delivery of the notification to a webhook
+	// +optional
+	Webhook *QuayNotificationWebhook `json:"webhook,omitempty"`
+	// Email configures the delivery of the notification by email
+	// +optional
+	Email *QuayNotificationEmail `json:"email,omitempty"`
+	// Slack configures the delivery of the notification to Slack
+	// +optional
+	Slack *QuayNotificationSlack `json:"slack,omitempty"`
+}
+
+// QuayNotificationWebhook defines the webhook receiving a notification
+// Either the URL or a Secret containing the URL in its url key must be specified
+// +k8s:openapi-gen=true
+type QuayNotificationWebhook struct {
+	// URL is the URL receiving the notification
+	// +optional
+	URL string `json:"url,omitempty"`
+	// URLSecretName is the name of a Secret containing the URL receiving the notification
+	// +optional
+	URLSecretName string `json:"urlSecretName,omitempty"`
+	// BodyTemplate is a JSON template for the body of the request replacing the default payload of the event
+	// +optional
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+}
+
+// QuayNotificationEmail defines the recipient of a notification delivered by email
+// +k8s:openapi-gen=true
+type QuayNotificationEmail struct {
+	// Address is the email address receiving the notification. Quay only delivers notifications to addresses authorized for the repository
+	Address string `json:"address"`
+}
+
+// QuayNotificationSlack defines the Slack incoming webhook receiving a notification
+// Either the URL or a Secret containing the URL in its url key must be specified
+// +k8s:openapi-gen=true
+type QuayNotificationSlack struct {
+	// URL is the URL of the Slack incoming webhook
+	// +optional
+	URL string `json:"url,omitempty"`
+	// URLSecretName is the name of a Secret containing the URL of the Slack incoming webhook
+	// +optional
+	URLSecretName string `json:"urlSecretName,omitempty"`
+}
+
+// QuayNotificationStatus defines the observed state of QuayNotification
+// +k8s:openapi-gen=true
+type QuayNotificationStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// RepositoryName is the full name of the repository containing the notification in Quay
+	// +optional
+	RepositoryName string `json:"repositoryName,omitempty"`
+	// UUID is the identifier of the notification in Quay
+	// +optional
+	UUID string `json:"uuid,omitempty"`
+	// ConfigChecksum is the checksum of the configuration of the notification last provided to Quay
+	// +optional
+	ConfigChecksum string `json:"configChecksum,omitempty"`
+	// NumberOfFailures is the number of consecutive failed deliveries of the notification reported by Quay
+	// +optional
+	NumberOfFailures int32 `json:"numberOfFailures,omitempty"`
+	// TestRequest is the value of the test annotation last handled
+	// +optional
+	TestRequest string `json:"testRequest,omitempty"`
+	// LastTestTime is the time a test notification was last triggered
+	// +optional
+	LastTestTime *metav1.Time `json:"lastTestTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayNotification is the Schema for the quaynotifications API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quaynotifications,scope=Namespaced
+// +kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".status.repositoryName",description="Full name of the repository in Quay"
+// +kubebuilder:printcolumn:name="Event",type="string",JSONPath=".spec.event",description="Repository event triggering the notification"
+// +kubebuilder:printcolumn:name="Method",type="string",JSONPath=".spec.method",description="How the notification is delivered"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the notification is synchronized with Quay"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayNotification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayNotificationSpec   `json:"spec,omitempty"`
+	Status QuayNotificationStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayNotificationList contains a list of QuayNotification
+type QuayNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayNotification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayNotification{}, &QuayNotificationList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayNotification) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayNotification) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
+
+// GetFullRepositoryName returns the name of the repository including its namespace
+func (q *QuayNotification) GetFullRepositoryName() string {
+	return q.Spec.Namespace + "/" + q.Spec.Repository
+}
+
+// GetTitle returns the title of the notification in Quay
+func (q *QuayNotification) GetTitle() string {
+
+	if q.Spec.Title == "" {
+		return q.Name
+	}
+
+	return q.Spec.Title
+}
+
+// GetURLSecretName returns the name of the Secret containing the URL receiving the notification or an empty string when none is referenced
+func (q *QuayNotification) GetURLSecretName() string {
+
+	switch {
+	case q.Spec.Method == WebhookQuayNotificationMethod && q.Spec.Webhook != nil:
+		return q.Spec.Webhook.URLSecretName
+	case q.Spec.Method == SlackQuayNotificationMethod && q.Spec.Slack != nil:
+		return q.Spec.Slack.URLSecretName
+	}
+
+	return ""
+}
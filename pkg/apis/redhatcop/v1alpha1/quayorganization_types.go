@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuayOrganizationSpec defines the desired state of QuayOrganization
+// +k8s:openapi-gen=true
+type QuayOrganizationSpec struct {
+	QuayResourceSpec `json:",inline"`
+	// Name is the name of the organization in Quay. Defaults to the name of the QuayOrganization
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Email is the email address associated with the organization
+	// +optional
+	Email string `json:"email,omitempty"`
+}
+
+// QuayOrganizationStatus defines the observed state of QuayOrganization
+// +k8s:openapi-gen=true
+type QuayOrganizationStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// OrganizationName is the name of the organization managed in Quay
+	// +optional
+	OrganizationName string `json:"organizationName,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayOrganization is the Schema for the quayorganizations API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quayorganizations,scope=Namespaced
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".status.organizationName",description="Name of the organization in Quay"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the organization is synchronized with Quay"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayOrganization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayOrganizationSpec   `json:"spec,omitempty"`
+	Status QuayOrganizationStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayOrganizationList contains a list of QuayOrganization
+type QuayOrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayOrganization `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayOrganization{}, &QuayOrganizationList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayOrganization) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayOrganization) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
+
+// GetOrganizationName returns the name of the organization in Quay
+func (q *QuayOrganization) GetOrganizationName() string {
+
+	if q.Spec.Name != "" {
+		return q.Spec.Name
+	}
+
+	return q.Name
+}
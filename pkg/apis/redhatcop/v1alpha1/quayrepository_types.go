@@ -0,0 +1,155 @@
+package v1alpha1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuayRepositoryVisibility defines who can pull from a repository
+type QuayRepositoryVisibility string
+
+// QuayRepositoryRole defines the access granted on a repository
+type QuayRepositoryRole string
+
+const (
+	// PublicQuayRepositoryVisibility allows anyone to pull from the repository
+	PublicQuayRepositoryVisibility QuayRepositoryVisibility = "public"
+
+	// PrivateQuayRepositoryVisibility only allows those with permissions to pull from the repository
+	PrivateQuayRepositoryVisibility QuayRepositoryVisibility = "private"
+
+	// ReadQuayRepositoryRole allows pulling from the repository
+	ReadQuayRepositoryRole QuayRepositoryRole = "read"
+
+	// WriteQuayRepositoryRole allows pulling from and pushing to the repository
+	WriteQuayRepositoryRole QuayRepositoryRole = "write"
+
+	// AdminQuayRepositoryRole allows full control of the repository
+	AdminQuayRepositoryRole QuayRepositoryRole = "admin"
+)
+
+// QuayRepositorySpec defines the desired state of QuayRepository
+// +k8s:openapi-gen=true
+type QuayRepositorySpec struct {
+	QuayResourceSpec `json:",inline"`
+	// Namespace is the organization or user in Quay containing the repository
+	Namespace string `json:"namespace"`
+	// Name is the name of the repository in Quay. Defaults to the name of the QuayRepository
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Visibility determines who can pull from the repository. Defaults to private
+	// +optional
+	// +kubebuilder:validation:Enum=public;private
+	Visibility QuayRepositoryVisibility `json:"visibility,omitempty"`
+	// Description is the description of the repository
+	// +optional
+	Description string `json:"description,omitempty"`
+	// UserPermissions are the permissions granted to users. Permissions of users are not managed when omitted
+	// +optional
+	// +listType=atomic
+	UserPermissions []QuayRepositoryPermission `json:"userPermissions,omitempty"`
+	// TeamPermissions are the permissions granted to teams of the organization. Permissions of teams are not managed when omitted
+	// +optional
+	// +listType=atomic
+	TeamPermissions []QuayRepositoryPermission `json:"teamPermissions,omitempty"`
+	// RobotPermissions are the permissions granted to robot accounts. Permissions of robot accounts are not managed when omitted
+	// +optional
+	// +listType=atomic
+	RobotPermissions []QuayRepositoryPermission `json:"robotPermissions,omitempty"`
+}
+
+// QuayRepositoryPermission defines the access granted on a repository to a user, team or robot account
+// +k8s:openapi-gen=true
+type QuayRepositoryPermission struct {
+	// Name is the name of the user, team or robot account. Robot accounts can be referenced by their short name
+	Name string `json:"name"`
+	// Role is the access granted on the repository
+	// +kubebuilder:validation:Enum=read;write;admin
+	Role QuayRepositoryRole `json:"role"`
+}
+
+// QuayRepositoryStatus defines the observed state of QuayRepository
+// +k8s:openapi-gen=true
+type QuayRepositoryStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// RepositoryName is the full name of the repository managed in Quay
+	// +optional
+	RepositoryName string `json:"repositoryName,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayRepository is the Schema for the quayrepositories API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quayrepositories,scope=Namespaced
+// +kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".status.repositoryName",description="Full name of the repository in Quay"
+// +kubebuilder:printcolumn:name="Visibility",type="string",JSONPath=".spec.visibility",description="Visibility of the repository"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the repository is synchronized with Quay"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayRepositorySpec   `json:"spec,omitempty"`
+	Status QuayRepositoryStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayRepositoryList contains a list of QuayRepository
+type QuayRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayRepository `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayRepository{}, &QuayRepositoryList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayRepository) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayRepository) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
+
+// GetRepositoryName returns the name of the repository in Quay
+func (q *QuayRepository) GetRepositoryName() string {
+
+	if q.Spec.Name != "" {
+		return q.Spec.Name
+	}
+
+	return q.Name
+}
+
+// GetFullRepositoryName returns the name of the repository including its namespace
+func (q *QuayRepository) GetFullRepositoryName() string {
+	return q.Spec.Namespace + "/" + q.GetRepositoryName()
+}
+
+// GetVisibility returns the visibility of the repository
+func (q *QuayRepository) GetVisibility() QuayRepositoryVisibility {
+
+	if q.Spec.Visibility == "" {
+		return PrivateQuayRepositoryVisibility
+	}
+
+	return q.Spec.Visibility
+}
+
+// GetRobotAccountName returns the full name of a robot account referenced in the repository namespace
+func (q *QuayRepository) GetRobotAccountName(name string) string {
+
+	if strings.Contains(name, "+") {
+		return name
+	}
+
+	return q.Spec.Namespace + "+" + name
+}
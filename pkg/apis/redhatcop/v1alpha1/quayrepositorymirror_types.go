@@ -0,0 +1,162 @@
+package v1alpha1
+
+import (
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuayRepositoryMirrorSyncStatus defines the state of the latest synchronization of a mirrored repository reported by Quay
+type QuayRepositoryMirrorSyncStatus string
+
+const (
+	// NeverRunQuayRepositoryMirrorSyncStatus indicates that the repository has not been synchronized yet
+	NeverRunQuayRepositoryMirrorSyncStatus QuayRepositoryMirrorSyncStatus = "NEVER_RUN"
+
+	// SyncNowQuayRepositoryMirrorSyncStatus indicates that an immediate synchronization has been requested
+	SyncNowQuayRepositoryMirrorSyncStatus QuayRepositoryMirrorSyncStatus = "SYNC_NOW"
+
+	// SyncingQuayRepositoryMirrorSyncStatus indicates that the repository is being synchronized
+	SyncingQuayRepositoryMirrorSyncStatus QuayRepositoryMirrorSyncStatus = "SYNCING"
+
+	// SuccessQuayRepositoryMirrorSyncStatus indicates that the latest synchronization succeeded
+	SuccessQuayRepositoryMirrorSyncStatus QuayRepositoryMirrorSyncStatus = "SUCCESS"
+
+	// FailQuayRepositoryMirrorSyncStatus indicates that the latest synchronization failed
+	FailQuayRepositoryMirrorSyncStatus QuayRepositoryMirrorSyncStatus = "FAIL"
+
+	// CancelQuayRepositoryMirrorSyncStatus indicates that the latest synchronization was cancelled
+	CancelQuayRepositoryMirrorSyncStatus QuayRepositoryMirrorSyncStatus = "CANCEL"
+
+	// DefaultQuayRepositoryMirrorSyncInterval is the interval between synchronizations when none is specified
+	DefaultQuayRepositoryMirrorSyncInterval = 24 * time.Hour
+)
+
+// QuayRepositoryMirrorSpec defines the desired state of QuayRepositoryMirror
+// +k8s:openapi-gen=true
+type QuayRepositoryMirrorSpec struct {
+	QuayResourceSpec `json:",inline"`
+	// Namespace is the organization or user in Quay containing the repository
+	Namespace string `json:"namespace"`
+	// Repository is the name of the existing repository in Quay that is mirrored
+	Repository string `json:"repository"`
+	// ExternalReference is the location of the repository mirrored from an external registry such as quay.io/coreos/etcd
+	ExternalReference string `json:"externalReference"`
+	// TagPatterns are the patterns matching the tags that are mirrored such as latest or v3.*
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	TagPatterns []string `json:"tagPatterns"`
+	// SyncInterval is the interval between synchronizations of the repository. Defaults to 24h
+	// +optional
+	SyncInterval *metav1.Duration `json:"syncInterval,omitempty"`
+	// RobotAccount is the robot account used to push the mirrored images. Robot accounts can be referenced by their short name
+	RobotAccount string `json:"robotAccount"`
+	// CredentialsSecretName is the name of a Secret containing the username and password used to access the external registry
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+	// VerifyTLS determines whether the certificate of the external registry is verified. Defaults to true
+	// +optional
+	VerifyTLS *bool `json:"verifyTLS,omitempty"`
+	// Suspend disables the scheduled synchronization of the repository
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// QuayRepositoryMirrorStatus defines the observed state of QuayRepositoryMirror
+// +k8s:openapi-gen=true
+type QuayRepositoryMirrorStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// RepositoryName is the full name of the mirrored repository in Quay
+	// +optional
+	RepositoryName string `json:"repositoryName,omitempty"`
+	// MirrorSyncStatus is the state of the latest synchronization of the repository reported by Quay
+	// +optional
+	MirrorSyncStatus QuayRepositoryMirrorSyncStatus `json:"mirrorSyncStatus,omitempty"`
+	// LastMirrorSyncTime is the time the operator observed the completion of the latest synchronization of the repository
+	// +optional
+	LastMirrorSyncTime *metav1.Time `json:"lastMirrorSyncTime,omitempty"`
+	// NextMirrorSyncTime is the time of the next scheduled synchronization of the repository
+	// +optional
+	NextMirrorSyncTime *metav1.Time `json:"nextMirrorSyncTime,omitempty"`
+	// CredentialsChecksum is the checksum of the credentials last provided to Quay for the external registry
+	// +optional
+	CredentialsChecksum string `json:"credentialsChecksum,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayRepositoryMirror is the Schema for the quayrepositorymirrors API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quayrepositorymirrors,scope=Namespaced
+// +kubebuilder:printcolumn:name="Repository",type="string",JSONPath=".status.repositoryName",description="Full name of the mirrored repository in Quay"
+// +kubebuilder:printcolumn:name="External Reference",type="string",JSONPath=".spec.externalReference",description="Location of the repository in the external registry"
+// +kubebuilder:printcolumn:name="Mirror Status",type="string",JSONPath=".status.mirrorSyncStatus",description="State of the latest synchronization of the repository"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the mirror configuration is synchronized with Quay"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayRepositoryMirror struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayRepositoryMirrorSpec   `json:"spec,omitempty"`
+	Status QuayRepositoryMirrorStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayRepositoryMirrorList contains a list of QuayRepositoryMirror
+type QuayRepositoryMirrorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayRepositoryMirror `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayRepositoryMirror{}, &QuayRepositoryMirrorList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayRepositoryMirror) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayRepositoryMirror) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
+
+// GetFullRepositoryName returns the name of the mirrored repository including its namespace
+func (q *QuayRepositoryMirror) GetFullRepositoryName() string {
+	return q.Spec.Namespace + "/" + q.Spec.Repository
+}
+
+// GetSyncInterval returns the interval between synchronizations of the repository
+func (q *QuayRepositoryMirror) GetSyncInterval() time.Duration {
+
+	if q.Spec.SyncInterval == nil {
+		return DefaultQuayRepositoryMirrorSyncInterval
+	}
+
+	return q.Spec.SyncInterval.Duration
+}
+
+// IsVerifyTLS determines whether the certificate of the external registry is verified
+func (q *QuayRepositoryMirror) IsVerifyTLS() bool {
+	return q.Spec.VerifyTLS == nil || *q.Spec.VerifyTLS
+}
+
+// GetRobotAccountName returns the full name of the robot account pushing the mirrored images
+func (q *QuayRepositoryMirror) GetRobotAccountName() string {
+
+	if strings.Contains(q.Spec.RobotAccount, "+") {
+		return q.Spec.RobotAccount
+	}
+
+	return q.Spec.Namespace + "+" + q.Spec.RobotAccount
+}
+
+// IsSyncInProgress determines whether Quay reported that a synchronization of the repository is pending or running
+func (s QuayRepositoryMirrorSyncStatus) IsSyncInProgress() bool {
+	return s == SyncNowQuayRepositoryMirrorSyncStatus || s == SyncingQuayRepositoryMirrorSyncStatus
+}
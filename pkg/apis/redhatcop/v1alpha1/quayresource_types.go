@@ -0,0 +1,143 @@
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// QuayResourceDeletionPolicy defines what happens to the object in Quay when the resource managing it is deleted
+type QuayResourceDeletionPolicy string
+
+// QuayResourceConditionType defines the types of conditions reported by resources managed through the Quay API
+type QuayResourceConditionType string
+
+// QuayResourceConditionReason defines the reason a condition is in its current state
+type QuayResourceConditionReason string
+
+const (
+	// RetainQuayResourceDeletionPolicy leaves the object in Quay when the resource is deleted
+	RetainQuayResourceDeletionPolicy QuayResourceDeletionPolicy = "Retain"
+
+	// DeleteQuayResourceDeletionPolicy deletes the object in Quay when the resource is deleted
+	DeleteQuayResourceDeletionPolicy QuayResourceDeletionPolicy = "Delete"
+
+	// QuayResourceSyncedCondition indicates whether the object in Quay matches the specification
+	QuayResourceSyncedCondition QuayResourceConditionType = "Synced"
+
+	// QuayResourceSyncSuccess indicates that the object was synchronized with Quay
+	QuayResourceSyncSuccess QuayResourceConditionReason = "SyncSuccess"
+
+	// QuayResourceSyncFailure indicates that an error occurred synchronizing the object with Quay
+	QuayResourceSyncFailure QuayResourceConditionReason = "SyncFailure"
+
+	// QuayResourceValidationFailure indicates that the specification is invalid
+	QuayResourceValidationFailure QuayResourceConditionReason = "ValidationFailure"
+
+	// QuayResourceQuayEcosystemNotReady indicates that the referenced QuayEcosystem cannot be used to access Quay
+	QuayResourceQuayEcosystemNotReady QuayResourceConditionReason = "QuayEcosystemNotReady"
+
+	// QuayResourceDeletionFailure indicates that an error occurred deleting the object from Quay
+	QuayResourceDeletionFailure QuayResourceConditionReason = "DeletionFailure"
+)
+
+// QuayResourceSpec defines the properties shared by resources managed through the Quay API
+// +k8s:openapi-gen=true
+type QuayResourceSpec struct {
+	// QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance
+	QuayEcosystemName string `json:"quayEcosystemName"`
+	// DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted
+	// +optional
+	// +kubebuilder:validation:Enum=Retain;Delete
+	DeletionPolicy QuayResourceDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// QuayResourceStatus defines the observed state shared by resources managed through the Quay API
+// +k8s:openapi-gen=true
+type QuayResourceStatus struct {
+	// ObservedGeneration is the most recent generation of the specification processed by the operator
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastSyncTime is the last time the object was successfully synchronized with Quay
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=atomic
+	Conditions []QuayResourceCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+}
+
+// QuayResourceCondition defines a condition reported by a resource managed through the Quay API
+// +k8s:openapi-gen=true
+type QuayResourceCondition struct {
+	LastTransitionTime metav1.Time               `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+	LastUpdateTime     metav1.Time               `json:"lastUpdateTime,omitempty" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+	Message            string                    `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	Reason             string                    `json:"reason,omitempty" protobuf:"bytes,5,opt,name=reason"`
+	Status             corev1.ConditionStatus    `json:"status" protobuf:"bytes,2,opt,name=status,casttype=k8s.io/kubernetes/pkg/api/v1.ConditionStatus"`
+	Type               QuayResourceConditionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=QuayResourceConditionType"`
+}
+
+// QuayResource is implemented by the resources managed through the Quay API
+// +k8s:deepcopy-gen=false
+type QuayResource interface {
+	metav1.Object
+	runtime.Object
+	GetQuayResourceSpec() *QuayResourceSpec
+	GetQuayResourceStatus() *QuayResourceStatus
+}
+
+// IsDeletionPolicyDelete determines whether the object is deleted from Quay when the resource is deleted
+func (s *QuayResourceSpec) IsDeletionPolicyDelete() bool {
+	return s.DeletionPolicy == DeleteQuayResourceDeletionPolicy
+}
+
+// SetCondition applies the condition
+func (s *QuayResourceStatus) SetCondition(newCondition QuayResourceCondition) *QuayResourceCondition {
+
+	now := metav1.NewTime(time.Now())
+
+	if s.Conditions == nil {
+		s.Conditions = []QuayResourceCondition{}
+	}
+
+	existingCondition, found := s.FindConditionByType(newCondition.Type)
+
+	if !found {
+		newCondition.LastTransitionTime = now
+		newCondition.LastUpdateTime = now
+
+		s.Conditions = append(s.Conditions, newCondition)
+
+		return &newCondition
+	}
+
+	if existingCondition.Status != newCondition.Status {
+		existingCondition.Status = newCondition.Status
+		existingCondition.LastTransitionTime = now
+		existingCondition.LastUpdateTime = now
+	}
+
+	if existingCondition.Reason != newCondition.Reason || existingCondition.Message != newCondition.Message {
+		existingCondition.Reason = newCondition.Reason
+		existingCondition.Message = newCondition.Message
+		existingCondition.LastUpdateTime = now
+	}
+
+	return existingCondition
+}
+
+// FindConditionByType locates the Condition by the type
+func (s *QuayResourceStatus) FindConditionByType(conditionType QuayResourceConditionType) (*QuayResourceCondition, bool) {
+
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == conditionType {
+			return &s.Conditions[i], true
+		}
+	}
+
+	return &QuayResourceCondition{}, false
+}
@@ -0,0 +1,120 @@
+package v1alpha1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuayRobotAccountSpec defines the desired state of QuayRobotAccount
+// +k8s:openapi-gen=true
+type QuayRobotAccountSpec struct {
+	QuayResourceSpec `json:",inline"`
+	// Organization is the organization in Quay containing the robot account
+	Organization string `json:"organization"`
+	// Name is the short name of the robot account in Quay. Defaults to the name of the QuayRobotAccount with dashes and dots replaced by underscores
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9_]{1,254}$`
+	Name string `json:"name,omitempty"`
+	// Description is the description of the robot account set when it is created
+	// +optional
+	Description string `json:"description,omitempty"`
+	// RepositoryPermissions are the permissions granted to the robot account on repositories of the organization. Permissions are not managed when omitted
+	// +optional
+	// +listType=atomic
+	RepositoryPermissions []QuayRobotAccountPermission `json:"repositoryPermissions,omitempty"`
+	// SecretName is the name of the Secret containing the credentials of the robot account. Defaults to the name of the QuayRobotAccount
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// QuayRobotAccountPermission defines the access granted to a robot account on a repository
+// +k8s:openapi-gen=true
+type QuayRobotAccountPermission struct {
+	// Repository is the name of the repository within the organization
+	Repository string `json:"repository"`
+	// Role is the access granted on the repository
+	// +kubebuilder:validation:Enum=read;write;admin
+	Role QuayRepositoryRole `json:"role"`
+}
+
+// QuayRobotAccountStatus defines the observed state of QuayRobotAccount
+// +k8s:openapi-gen=true
+type QuayRobotAccountStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// RobotAccountName is the full name of the robot account managed in Quay
+	// +optional
+	RobotAccountName string `json:"robotAccountName,omitempty"`
+	// SecretName is the name of the Secret containing the credentials of the robot account
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// TokenRegenerationRequest is the value of the token regeneration annotation that was last processed
+	// +optional
+	TokenRegenerationRequest string `json:"tokenRegenerationRequest,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayRobotAccount is the Schema for the quayrobotaccounts API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quayrobotaccounts,scope=Namespaced
+// +kubebuilder:printcolumn:name="Robot Account",type="string",JSONPath=".status.robotAccountName",description="Full name of the robot account in Quay"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".status.secretName",description="Secret containing the credentials of the robot account"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the robot account is synchronized with Quay"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayRobotAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayRobotAccountSpec   `json:"spec,omitempty"`
+	Status QuayRobotAccountStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayRobotAccountList contains a list of QuayRobotAccount
+type QuayRobotAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayRobotAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayRobotAccount{}, &QuayRobotAccountList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayRobotAccount) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayRobotAccount) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
+
+// GetRobotAccountShortName returns the name of the robot account within its organization
+func (q *QuayRobotAccount) GetRobotAccountShortName() string {
+
+	if q.Spec.Name != "" {
+		return q.Spec.Name
+	}
+
+	return strings.NewReplacer("-", "_", ".", "_").Replace(q.Name)
+}
+
+// GetRobotAccountName returns the full name of the robot account
+func (q *QuayRobotAccount) GetRobotAccountName() string {
+	return q.Spec.Organization + "+" + q.GetRobotAccountShortName()
+}
+
+// GetSecretName returns the name of the Secret containing the credentials of the robot account
+func (q *QuayRobotAccount) GetSecretName() string {
+
+	if q.Spec.SecretName != "" {
+		return q.Spec.SecretName
+	}
+
+	return q.Name
+}
@@ -0,0 +1,130 @@
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultQuayTagRetentionPolicyInterval is the interval between runs of the policy when none is specified
+	DefaultQuayTagRetentionPolicyInterval = time.Hour
+
+	// DefaultQuayTagRetentionPolicyRepositoryPattern matches every repository of the organization
+	DefaultQuayTagRetentionPolicyRepositoryPattern = "*"
+)
+
+// QuayTagRetentionPolicySpec defines the desired state of QuayTagRetentionPolicy
+// The KeepLast most recent tags of each repository are retained regardless of their age. The remaining tags are pruned when they are older than MaxAge,
+// or unconditionally when MaxAge is not specified. Tags matching ProtectedTagPattern are never pruned and do not count towards KeepLast
+// +k8s:openapi-gen=true
+type QuayTagRetentionPolicySpec struct {
+	// QuayResourceSpec references the QuayEcosystem. The deletion policy has no effect since pruned tags cannot be restored
+	QuayResourceSpec `json:",inline"`
+	// Organization is the organization in Quay containing the repositories the policy applies to
+	Organization string `json:"organization"`
+	// RepositoryPattern is a shell pattern such as ci-* matching the names of the repositories the policy applies to. Defaults to all repositories
+	// +optional
+	RepositoryPattern string `json:"repositoryPattern,omitempty"`
+	// KeepLast is the number of most recent tags of each repository that are retained
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast *int32 `json:"keepLast,omitempty"`
+	// MaxAge is the age after which tags beyond the KeepLast most recent tags are pruned
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+	// ProtectedTagPattern is a regular expression matching the tags that are never pruned such as ^(latest|v[0-9.]+)$
+	// +optional
+	ProtectedTagPattern string `json:"protectedTagPattern,omitempty"`
+	// Interval is the interval between runs of the policy. Defaults to 1h
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// DryRun reports the tags that would be pruned in the status without deleting them
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// QuayTagRetentionPolicyStatus defines the observed state of QuayTagRetentionPolicy
+// +k8s:openapi-gen=true
+type QuayTagRetentionPolicyStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// LastRunTime is the time of the latest run of the policy
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+	// NextRunTime is the time of the next scheduled run of the policy
+	// +optional
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+	// DryRun indicates whether the latest run only reported the tags that would be pruned
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// PrunedTagCount is the number of tags pruned by the latest run, or that would be pruned in dry-run mode
+	// +optional
+	PrunedTagCount int32 `json:"prunedTagCount,omitempty"`
+	// PrunedTags lists the first tags pruned by the latest run, or that would be pruned in dry-run mode, in the form repository:tag
+	// +optional
+	// +listType=atomic
+	PrunedTags []string `json:"prunedTags,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayTagRetentionPolicy is the Schema for the quaytagretentionpolicies API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quaytagretentionpolicies,scope=Namespaced
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organization",description="Organization containing the repositories the policy applies to"
+// +kubebuilder:printcolumn:name="Dry Run",type="boolean",JSONPath=".spec.dryRun",description="Whether tags are only reported instead of pruned"
+// +kubebuilder:printcolumn:name="Pruned",type="integer",JSONPath=".status.prunedTagCount",description="Number of tags pruned by the latest run"
+// +kubebuilder:printcolumn:name="Last Run",type="date",JSONPath=".status.lastRunTime",description="Time of the latest run of the policy"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the latest run of the policy succeeded"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayTagRetentionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayTagRetentionPolicySpec   `json:"spec,omitempty"`
+	Status QuayTagRetentionPolicyStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayTagRetentionPolicyList contains a list of QuayTagRetentionPolicy
+type QuayTagRetentionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayTagRetentionPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayTagRetentionPolicy{}, &QuayTagRetentionPolicyList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayTagRetentionPolicy) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayTagRetentionPolicy) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
+
+// GetRepositoryPattern returns the shell pattern matching the repositories the policy applies to
+func (q *QuayTagRetentionPolicy) GetRepositoryPattern() string {
+
+	if q.Spec.RepositoryPattern == "" {
+		return DefaultQuayTagRetentionPolicyRepositoryPattern
+	}
+
+	return q.Spec.RepositoryPattern
+}
+
+// GetInterval returns the interval between runs of the policy
+func (q *QuayTagRetentionPolicy) GetInterval() time.Duration {
+
+	if q.Spec.Interval == nil {
+		return DefaultQuayTagRetentionPolicyInterval
+	}
+
+	return q.Spec.Interval.Duration
+}
@@ -0,0 +1,159 @@
+package v1alpha1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuayTeamRole defines the access granted to the members of a team on their organization
+type QuayTeamRole string
+
+const (
+	// MemberQuayTeamRole grants access to the repositories the team is permitted on
+	MemberQuayTeamRole QuayTeamRole = "member"
+
+	// CreatorQuayTeamRole additionally allows creating repositories in the organization
+	CreatorQuayTeamRole QuayTeamRole = "creator"
+
+	// AdminQuayTeamRole grants full control of the organization
+	AdminQuayTeamRole QuayTeamRole = "admin"
+)
+
+// QuayTeamSpec defines the desired state of QuayTeam
+// +k8s:openapi-gen=true
+type QuayTeamSpec struct {
+	QuayResourceSpec `json:",inline"`
+	// Organization is the organization in Quay containing the team
+	Organization string `json:"organization"`
+	// Name is the name of the team in Quay. Defaults to the name of the QuayTeam
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Role is the access granted to the members of the team on the organization. Defaults to member
+	// +optional
+	// +kubebuilder:validation:Enum=member;creator;admin
+	Role QuayTeamRole `json:"role,omitempty"`
+	// Description is the description of the team
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Users are the users that are members of the team. Must be omitted when the team is synchronized with a group
+	// +optional
+	// +listType=set
+	Users []string `json:"users,omitempty"`
+	// Robots are the robot accounts that are members of the team. Robot accounts can be referenced by their short name
+	// +optional
+	// +listType=set
+	Robots []string `json:"robots,omitempty"`
+	// RepositoryPermissions are the permissions granted to the team on repositories of the organization. Permissions are not managed when omitted
+	// +optional
+	// +listType=atomic
+	RepositoryPermissions []QuayTeamPermission `json:"repositoryPermissions,omitempty"`
+	// Sync binds the membership of the team to a group of the external authentication provider of Quay
+	// +optional
+	Sync *QuayTeamSync `json:"sync,omitempty"`
+}
+
+// QuayTeamPermission defines the access granted to a team on a repository
+// +k8s:openapi-gen=true
+type QuayTeamPermission struct {
+	// Repository is the name of the repository within the organization
+	Repository string `json:"repository"`
+	// Role is the access granted on the repository
+	// +kubebuilder:validation:Enum=read;write;admin
+	Role QuayRepositoryRole `json:"role"`
+}
+
+// QuayTeamSync defines the group of the external authentication provider the team is synchronized with
+// +k8s:openapi-gen=true
+type QuayTeamSync struct {
+	// Group identifies the group such as the distinguished name of an LDAP group or the name of an OIDC group
+	Group string `json:"group"`
+}
+
+// QuayTeamStatus defines the observed state of QuayTeam
+// +k8s:openapi-gen=true
+type QuayTeamStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// TeamName is the full name of the team managed in Quay
+	// +optional
+	TeamName string `json:"teamName,omitempty"`
+	// SyncService is the external authentication provider the team is synchronized with
+	// +optional
+	SyncService string `json:"syncService,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayTeam is the Schema for the quayteams API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quayteams,scope=Namespaced
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".status.teamName",description="Full name of the team in Quay"
+// +kubebuilder:printcolumn:name="Role",type="string",JSONPath=".spec.role",description="Role of the team in the organization"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the team is synchronized with Quay"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayTeam struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayTeamSpec   `json:"spec,omitempty"`
+	Status QuayTeamStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayTeamList contains a list of QuayTeam
+type QuayTeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayTeam `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayTeam{}, &QuayTeamList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayTeam) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayTeam) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
+
+// GetTeamName returns the name of the team in Quay
+func (q *QuayTeam) GetTeamName() string {
+
+	if q.Spec.Name != "" {
+		return q.Spec.Name
+	}
+
+	return q.Name
+}
+
+// GetFullTeamName returns the name of the team including its organization
+func (q *QuayTeam) GetFullTeamName() string {
+	return q.Spec.Organization + "/" + q.GetTeamName()
+}
+
+// GetRole returns the role of the team in the organization
+func (q *QuayTeam) GetRole() QuayTeamRole {
+
+	if q.Spec.Role == "" {
+		return MemberQuayTeamRole
+	}
+
+	return q.Spec.Role
+}
+
+// GetRobotAccountName returns the full name of a robot account referenced in the organization of the team
+func (q *QuayTeam) GetRobotAccountName(name string) string {
+
+	if strings.Contains(name, "+") {
+		return name
+	}
+
+	return q.Spec.Organization + "+" + name
+}
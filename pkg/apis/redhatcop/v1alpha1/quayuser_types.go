@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuayUserSpec defines the desired state of QuayUser
+// Users are managed through the superuser API which is only available when Quay uses database authentication
+// +k8s:openapi-gen=true
+type QuayUserSpec struct {
+	QuayResourceSpec `json:",inline"`
+	// Username is the name of the user in Quay
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]{2,255}$`
+	Username string `json:"username"`
+	// Email is the email address of the user
+	Email string `json:"email"`
+	// PasswordSecretName is the name of a Secret containing the initial password of the user in its password key
+	// The password is only set when the user is created. Without it the password generated by Quay must be recovered by email
+	// +optional
+	PasswordSecretName string `json:"passwordSecretName,omitempty"`
+	// Disabled prevents the user from signing in
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// QuayUserStatus defines the observed state of QuayUser
+// +k8s:openapi-gen=true
+type QuayUserStatus struct {
+	QuayResourceStatus `json:",inline"`
+	// Username is the name of the user managed in Quay
+	// +optional
+	Username string `json:"username,omitempty"`
+	// Enabled indicates whether the user is allowed to sign in
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayUser is the Schema for the quayusers API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quayusers,scope=Namespaced
+// +kubebuilder:printcolumn:name="Username",type="string",JSONPath=".spec.username",description="Name of the user in Quay"
+// +kubebuilder:printcolumn:name="Email",type="string",JSONPath=".spec.email",description="Email address of the user"
+// +kubebuilder:printcolumn:name="Enabled",type="boolean",JSONPath=".status.enabled",description="Whether the user is allowed to sign in"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type==\"Synced\")].status",description="Whether the user is synchronized with Quay"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayUserSpec   `json:"spec,omitempty"`
+	Status QuayUserStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayUserList contains a list of QuayUser
+type QuayUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayUser `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayUser{}, &QuayUserList{})
+}
+
+// GetQuayResourceSpec returns the properties shared by resources managed through the Quay API
+func (q *QuayUser) GetQuayResourceSpec() *QuayResourceSpec {
+	return &q.Spec.QuayResourceSpec
+}
+
+// GetQuayResourceStatus returns the observed state shared by resources managed through the Quay API
+func (q *QuayUser) GetQuayResourceStatus() *QuayResourceStatus {
+	return &q.Status.QuayResourceStatus
+}
@@ -6,9 +6,56 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIToken) DeepCopyInto(out *APIToken) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]QuayAPITokenScope, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIToken.
+func (in *APIToken) DeepCopy() *APIToken {
+	if in == nil {
+		return nil
+	}
+	out := new(APIToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APITokenStatus) DeepCopyInto(out *APITokenStatus) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]QuayAPITokenScope, len(*in))
+		copy(*out, *in)
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APITokenStatus.
+func (in *APITokenStatus) DeepCopy() *APITokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APITokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureRegistryBackendSource) DeepCopyInto(out *AzureRegistryBackendSource) {
 	*out = *in
@@ -82,6 +129,11 @@ func (in *Clair) DeepCopyInto(out *Clair) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HorizontalPodAutoscaler != nil {
+		in, out := &in.HorizontalPodAutoscaler, &out.HorizontalPodAutoscaler
+		*out = new(HorizontalPodAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -111,6 +163,22 @@ func (in *CloudfrontS3RegistryBackendSource) DeepCopy() *CloudfrontS3RegistryBac
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigFile) DeepCopyInto(out *ConfigFile) {
 	*out = *in
@@ -281,6 +349,32 @@ func (in *GoogleCloudRegistryBackendSource) DeepCopy() *GoogleCloudRegistryBacke
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscaler) DeepCopyInto(out *HorizontalPodAutoscaler) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscaler.
+func (in *HorizontalPodAutoscaler) DeepCopy() *HorizontalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalPodAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalRegistryBackendSource) DeepCopyInto(out *LocalRegistryBackendSource) {
 	*out = *in
@@ -409,6 +503,16 @@ func (in *Quay) DeepCopyInto(out *Quay) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.HorizontalPodAutoscaler != nil {
+		in, out := &in.HorizontalPodAutoscaler, &out.HorizontalPodAutoscaler
+		*out = new(HorizontalPodAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoMirrorHorizontalPodAutoscaler != nil {
+		in, out := &in.RepoMirrorHorizontalPodAutoscaler, &out.RepoMirrorHorizontalPodAutoscaler
+		*out = new(HorizontalPodAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]v1.Toleration, len(*in))
@@ -416,6 +520,16 @@ func (in *Quay) DeepCopyInto(out *Quay) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.APIToken != nil {
+		in, out := &in.APIToken, &out.APIToken
+		*out = new(APIToken)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SmokeTest != nil {
+		in, out := &in.SmokeTest, &out.SmokeTest
+		*out = new(RegistrySmokeTest)
+		**out = **in
+	}
 	return
 }
 
@@ -457,6 +571,57 @@ func (in *QuayEcosystem) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayEcosystemComponentsStatus) DeepCopyInto(out *QuayEcosystemComponentsStatus) {
+	*out = *in
+	if in.Quay != nil {
+		in, out := &in.Quay, &out.Quay
+		*out = new(ComponentStatus)
+		**out = **in
+	}
+	if in.QuayConfig != nil {
+		in, out := &in.QuayConfig, &out.QuayConfig
+		*out = new(ComponentStatus)
+		**out = **in
+	}
+	if in.RepoMirror != nil {
+		in, out := &in.RepoMirror, &out.RepoMirror
+		*out = new(ComponentStatus)
+		**out = **in
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(ComponentStatus)
+		**out = **in
+	}
+	if in.QuayDatabase != nil {
+		in, out := &in.QuayDatabase, &out.QuayDatabase
+		*out = new(ComponentStatus)
+		**out = **in
+	}
+	if in.Clair != nil {
+		in, out := &in.Clair, &out.Clair
+		*out = new(ComponentStatus)
+		**out = **in
+	}
+	if in.ClairDatabase != nil {
+		in, out := &in.ClairDatabase, &out.ClairDatabase
+		*out = new(ComponentStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayEcosystemComponentsStatus.
+func (in *QuayEcosystemComponentsStatus) DeepCopy() *QuayEcosystemComponentsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayEcosystemComponentsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuayEcosystemCondition) DeepCopyInto(out *QuayEcosystemCondition) {
 	*out = *in
@@ -549,6 +714,26 @@ func (in *QuayEcosystemStatus) DeepCopyInto(out *QuayEcosystemStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EffectiveSpec != nil {
+		in, out := &in.EffectiveSpec, &out.EffectiveSpec
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = new(QuayEcosystemComponentsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIToken != nil {
+		in, out := &in.APIToken, &out.APIToken
+		*out = new(APITokenStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SmokeTest != nil {
+		in, out := &in.SmokeTest, &out.SmokeTest
+		*out = new(RegistrySmokeTestStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -563,174 +748,1260 @@ func (in *QuayEcosystemStatus) DeepCopy() *QuayEcosystemStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RADOSRegistryBackendSource) DeepCopyInto(out *RADOSRegistryBackendSource) {
+func (in *QuayNotification) DeepCopyInto(out *QuayNotification) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RADOSRegistryBackendSource.
-func (in *RADOSRegistryBackendSource) DeepCopy() *RADOSRegistryBackendSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayNotification.
+func (in *QuayNotification) DeepCopy() *QuayNotification {
 	if in == nil {
 		return nil
 	}
-	out := new(RADOSRegistryBackendSource)
+	out := new(QuayNotification)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayNotification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RHOCSRegistryBackendSource) DeepCopyInto(out *RHOCSRegistryBackendSource) {
+func (in *QuayNotificationEmail) DeepCopyInto(out *QuayNotificationEmail) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHOCSRegistryBackendSource.
-func (in *RHOCSRegistryBackendSource) DeepCopy() *RHOCSRegistryBackendSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayNotificationEmail.
+func (in *QuayNotificationEmail) DeepCopy() *QuayNotificationEmail {
 	if in == nil {
 		return nil
 	}
-	out := new(RHOCSRegistryBackendSource)
+	out := new(QuayNotificationEmail)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Redis) DeepCopyInto(out *Redis) {
+func (in *QuayNotificationList) DeepCopyInto(out *QuayNotificationList) {
 	*out = *in
-	if in.EnvVars != nil {
-		in, out := &in.EnvVars, &out.EnvVars
-		*out = make([]v1.EnvVar, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayNotification, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.LivenessProbe != nil {
-		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(v1.Probe)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayNotificationList.
+func (in *QuayNotificationList) DeepCopy() *QuayNotificationList {
+	if in == nil {
+		return nil
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
+	out := new(QuayNotificationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayNotificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayNotificationSlack) DeepCopyInto(out *QuayNotificationSlack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayNotificationSlack.
+func (in *QuayNotificationSlack) DeepCopy() *QuayNotificationSlack {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayNotificationSlack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayNotificationSpec) DeepCopyInto(out *QuayNotificationSpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	if in.EventConfig != nil {
+		in, out := &in.EventConfig, &out.EventConfig
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(QuayNotificationWebhook)
 		**out = **in
 	}
-	if in.ReadinessProbe != nil {
-		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(v1.Probe)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(QuayNotificationEmail)
 		**out = **in
 	}
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.SecurityContext != nil {
-		in, out := &in.SecurityContext, &out.SecurityContext
-		*out = new(v1.PodSecurityContext)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(QuayNotificationSlack)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redis.
-func (in *Redis) DeepCopy() *Redis {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayNotificationSpec.
+func (in *QuayNotificationSpec) DeepCopy() *QuayNotificationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Redis)
+	out := new(QuayNotificationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistryBackend) DeepCopyInto(out *RegistryBackend) {
+func (in *QuayNotificationStatus) DeepCopyInto(out *QuayNotificationStatus) {
 	*out = *in
-	in.RegistryBackendSource.DeepCopyInto(&out.RegistryBackendSource)
-	if in.ReplicateByDefault != nil {
-		in, out := &in.ReplicateByDefault, &out.ReplicateByDefault
-		*out = new(bool)
-		**out = **in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	if in.LastTestTime != nil {
+		in, out := &in.LastTestTime, &out.LastTestTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryBackend.
-func (in *RegistryBackend) DeepCopy() *RegistryBackend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayNotificationStatus.
+func (in *QuayNotificationStatus) DeepCopy() *QuayNotificationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistryBackend)
+	out := new(QuayNotificationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistryBackendSource) DeepCopyInto(out *RegistryBackendSource) {
+func (in *QuayNotificationWebhook) DeepCopyInto(out *QuayNotificationWebhook) {
 	*out = *in
-	if in.Local != nil {
-		in, out := &in.Local, &out.Local
-		*out = new(LocalRegistryBackendSource)
-		**out = **in
-	}
-	if in.S3 != nil {
-		in, out := &in.S3, &out.S3
-		*out = new(S3RegistryBackendSource)
-		**out = **in
-	}
-	if in.GoogleCloud != nil {
-		in, out := &in.GoogleCloud, &out.GoogleCloud
-		*out = new(GoogleCloudRegistryBackendSource)
-		**out = **in
-	}
-	if in.Azure != nil {
-		in, out := &in.Azure, &out.Azure
-		*out = new(AzureRegistryBackendSource)
-		**out = **in
-	}
-	if in.RADOS != nil {
-		in, out := &in.RADOS, &out.RADOS
-		*out = new(RADOSRegistryBackendSource)
-		**out = **in
-	}
-	if in.RHOCS != nil {
-		in, out := &in.RHOCS, &out.RHOCS
-		*out = new(RHOCSRegistryBackendSource)
-		**out = **in
-	}
-	if in.Swift != nil {
-		in, out := &in.Swift, &out.Swift
-		*out = new(SwiftRegistryBackendSource)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CloudfrontS3 != nil {
-		in, out := &in.CloudfrontS3, &out.CloudfrontS3
-		*out = new(CloudfrontS3RegistryBackendSource)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryBackendSource.
-func (in *RegistryBackendSource) DeepCopy() *RegistryBackendSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayNotificationWebhook.
+func (in *QuayNotificationWebhook) DeepCopy() *QuayNotificationWebhook {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistryBackendSource)
+	out := new(QuayNotificationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayOrganization) DeepCopyInto(out *QuayOrganization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayOrganization.
+func (in *QuayOrganization) DeepCopy() *QuayOrganization {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayOrganization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayOrganization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayOrganizationList) DeepCopyInto(out *QuayOrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayOrganization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayOrganizationList.
+func (in *QuayOrganizationList) DeepCopy() *QuayOrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayOrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayOrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayOrganizationSpec) DeepCopyInto(out *QuayOrganizationSpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayOrganizationSpec.
+func (in *QuayOrganizationSpec) DeepCopy() *QuayOrganizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayOrganizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayOrganizationStatus) DeepCopyInto(out *QuayOrganizationStatus) {
+	*out = *in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayOrganizationStatus.
+func (in *QuayOrganizationStatus) DeepCopy() *QuayOrganizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayOrganizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepository) DeepCopyInto(out *QuayRepository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepository.
+func (in *QuayRepository) DeepCopy() *QuayRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayRepository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositoryList) DeepCopyInto(out *QuayRepositoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositoryList.
+func (in *QuayRepositoryList) DeepCopy() *QuayRepositoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayRepositoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositoryMirror) DeepCopyInto(out *QuayRepositoryMirror) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositoryMirror.
+func (in *QuayRepositoryMirror) DeepCopy() *QuayRepositoryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositoryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayRepositoryMirror) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositoryMirrorList) DeepCopyInto(out *QuayRepositoryMirrorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayRepositoryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositoryMirrorList.
+func (in *QuayRepositoryMirrorList) DeepCopy() *QuayRepositoryMirrorList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositoryMirrorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayRepositoryMirrorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositoryMirrorSpec) DeepCopyInto(out *QuayRepositoryMirrorSpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	if in.TagPatterns != nil {
+		in, out := &in.TagPatterns, &out.TagPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncInterval != nil {
+		in, out := &in.SyncInterval, &out.SyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.VerifyTLS != nil {
+		in, out := &in.VerifyTLS, &out.VerifyTLS
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositoryMirrorSpec.
+func (in *QuayRepositoryMirrorSpec) DeepCopy() *QuayRepositoryMirrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositoryMirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositoryMirrorStatus) DeepCopyInto(out *QuayRepositoryMirrorStatus) {
+	*out = *in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	if in.LastMirrorSyncTime != nil {
+		in, out := &in.LastMirrorSyncTime, &out.LastMirrorSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextMirrorSyncTime != nil {
+		in, out := &in.NextMirrorSyncTime, &out.NextMirrorSyncTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositoryMirrorStatus.
+func (in *QuayRepositoryMirrorStatus) DeepCopy() *QuayRepositoryMirrorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositoryMirrorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositoryPermission) DeepCopyInto(out *QuayRepositoryPermission) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositoryPermission.
+func (in *QuayRepositoryPermission) DeepCopy() *QuayRepositoryPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositoryPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositorySpec) DeepCopyInto(out *QuayRepositorySpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	if in.UserPermissions != nil {
+		in, out := &in.UserPermissions, &out.UserPermissions
+		*out = make([]QuayRepositoryPermission, len(*in))
+		copy(*out, *in)
+	}
+	if in.TeamPermissions != nil {
+		in, out := &in.TeamPermissions, &out.TeamPermissions
+		*out = make([]QuayRepositoryPermission, len(*in))
+		copy(*out, *in)
+	}
+	if in.RobotPermissions != nil {
+		in, out := &in.RobotPermissions, &out.RobotPermissions
+		*out = make([]QuayRepositoryPermission, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositorySpec.
+func (in *QuayRepositorySpec) DeepCopy() *QuayRepositorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRepositoryStatus) DeepCopyInto(out *QuayRepositoryStatus) {
+	*out = *in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRepositoryStatus.
+func (in *QuayRepositoryStatus) DeepCopy() *QuayRepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayResourceCondition) DeepCopyInto(out *QuayResourceCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayResourceCondition.
+func (in *QuayResourceCondition) DeepCopy() *QuayResourceCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayResourceCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayResourceSpec) DeepCopyInto(out *QuayResourceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayResourceSpec.
+func (in *QuayResourceSpec) DeepCopy() *QuayResourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayResourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayResourceStatus) DeepCopyInto(out *QuayResourceStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]QuayResourceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayResourceStatus.
+func (in *QuayResourceStatus) DeepCopy() *QuayResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRobotAccount) DeepCopyInto(out *QuayRobotAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRobotAccount.
+func (in *QuayRobotAccount) DeepCopy() *QuayRobotAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRobotAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayRobotAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRobotAccountList) DeepCopyInto(out *QuayRobotAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayRobotAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRobotAccountList.
+func (in *QuayRobotAccountList) DeepCopy() *QuayRobotAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRobotAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayRobotAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRobotAccountPermission) DeepCopyInto(out *QuayRobotAccountPermission) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRobotAccountPermission.
+func (in *QuayRobotAccountPermission) DeepCopy() *QuayRobotAccountPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRobotAccountPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRobotAccountSpec) DeepCopyInto(out *QuayRobotAccountSpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	if in.RepositoryPermissions != nil {
+		in, out := &in.RepositoryPermissions, &out.RepositoryPermissions
+		*out = make([]QuayRobotAccountPermission, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRobotAccountSpec.
+func (in *QuayRobotAccountSpec) DeepCopy() *QuayRobotAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRobotAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayRobotAccountStatus) DeepCopyInto(out *QuayRobotAccountStatus) {
+	*out = *in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayRobotAccountStatus.
+func (in *QuayRobotAccountStatus) DeepCopy() *QuayRobotAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayRobotAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTagRetentionPolicy) DeepCopyInto(out *QuayTagRetentionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTagRetentionPolicy.
+func (in *QuayTagRetentionPolicy) DeepCopy() *QuayTagRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTagRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayTagRetentionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTagRetentionPolicyList) DeepCopyInto(out *QuayTagRetentionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayTagRetentionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTagRetentionPolicyList.
+func (in *QuayTagRetentionPolicyList) DeepCopy() *QuayTagRetentionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTagRetentionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayTagRetentionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTagRetentionPolicySpec) DeepCopyInto(out *QuayTagRetentionPolicySpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTagRetentionPolicySpec.
+func (in *QuayTagRetentionPolicySpec) DeepCopy() *QuayTagRetentionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTagRetentionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTagRetentionPolicyStatus) DeepCopyInto(out *QuayTagRetentionPolicyStatus) {
+	*out = *in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PrunedTags != nil {
+		in, out := &in.PrunedTags, &out.PrunedTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTagRetentionPolicyStatus.
+func (in *QuayTagRetentionPolicyStatus) DeepCopy() *QuayTagRetentionPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTagRetentionPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTeam) DeepCopyInto(out *QuayTeam) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTeam.
+func (in *QuayTeam) DeepCopy() *QuayTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayTeam) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTeamList) DeepCopyInto(out *QuayTeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTeamList.
+func (in *QuayTeamList) DeepCopy() *QuayTeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayTeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTeamPermission) DeepCopyInto(out *QuayTeamPermission) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTeamPermission.
+func (in *QuayTeamPermission) DeepCopy() *QuayTeamPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTeamPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTeamSpec) DeepCopyInto(out *QuayTeamSpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Robots != nil {
+		in, out := &in.Robots, &out.Robots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RepositoryPermissions != nil {
+		in, out := &in.RepositoryPermissions, &out.RepositoryPermissions
+		*out = make([]QuayTeamPermission, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(QuayTeamSync)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTeamSpec.
+func (in *QuayTeamSpec) DeepCopy() *QuayTeamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTeamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTeamStatus) DeepCopyInto(out *QuayTeamStatus) {
+	*out = *in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTeamStatus.
+func (in *QuayTeamStatus) DeepCopy() *QuayTeamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTeamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayTeamSync) DeepCopyInto(out *QuayTeamSync) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayTeamSync.
+func (in *QuayTeamSync) DeepCopy() *QuayTeamSync {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayTeamSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayUser) DeepCopyInto(out *QuayUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayUser.
+func (in *QuayUser) DeepCopy() *QuayUser {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayUserList) DeepCopyInto(out *QuayUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayUserList.
+func (in *QuayUserList) DeepCopy() *QuayUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayUserSpec) DeepCopyInto(out *QuayUserSpec) {
+	*out = *in
+	out.QuayResourceSpec = in.QuayResourceSpec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayUserSpec.
+func (in *QuayUserSpec) DeepCopy() *QuayUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayUserStatus) DeepCopyInto(out *QuayUserStatus) {
+	*out = *in
+	in.QuayResourceStatus.DeepCopyInto(&out.QuayResourceStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayUserStatus.
+func (in *QuayUserStatus) DeepCopy() *QuayUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RADOSRegistryBackendSource) DeepCopyInto(out *RADOSRegistryBackendSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RADOSRegistryBackendSource.
+func (in *RADOSRegistryBackendSource) DeepCopy() *RADOSRegistryBackendSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RADOSRegistryBackendSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RHOCSRegistryBackendSource) DeepCopyInto(out *RHOCSRegistryBackendSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RHOCSRegistryBackendSource.
+func (in *RHOCSRegistryBackendSource) DeepCopy() *RHOCSRegistryBackendSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RHOCSRegistryBackendSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Redis) DeepCopyInto(out *Redis) {
+	*out = *in
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redis.
+func (in *Redis) DeepCopy() *Redis {
+	if in == nil {
+		return nil
+	}
+	out := new(Redis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryBackend) DeepCopyInto(out *RegistryBackend) {
+	*out = *in
+	in.RegistryBackendSource.DeepCopyInto(&out.RegistryBackendSource)
+	if in.ReplicateByDefault != nil {
+		in, out := &in.ReplicateByDefault, &out.ReplicateByDefault
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryBackend.
+func (in *RegistryBackend) DeepCopy() *RegistryBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryBackendSource) DeepCopyInto(out *RegistryBackendSource) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRegistryBackendSource)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3RegistryBackendSource)
+		**out = **in
+	}
+	if in.GoogleCloud != nil {
+		in, out := &in.GoogleCloud, &out.GoogleCloud
+		*out = new(GoogleCloudRegistryBackendSource)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureRegistryBackendSource)
+		**out = **in
+	}
+	if in.RADOS != nil {
+		in, out := &in.RADOS, &out.RADOS
+		*out = new(RADOSRegistryBackendSource)
+		**out = **in
+	}
+	if in.RHOCS != nil {
+		in, out := &in.RHOCS, &out.RHOCS
+		*out = new(RHOCSRegistryBackendSource)
+		**out = **in
+	}
+	if in.Swift != nil {
+		in, out := &in.Swift, &out.Swift
+		*out = new(SwiftRegistryBackendSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudfrontS3 != nil {
+		in, out := &in.CloudfrontS3, &out.CloudfrontS3
+		*out = new(CloudfrontS3RegistryBackendSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryBackendSource.
+func (in *RegistryBackendSource) DeepCopy() *RegistryBackendSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryBackendSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrySmokeTest) DeepCopyInto(out *RegistrySmokeTest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySmokeTest.
+func (in *RegistrySmokeTest) DeepCopy() *RegistrySmokeTest {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistrySmokeTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrySmokeTestStatus) DeepCopyInto(out *RegistrySmokeTestStatus) {
+	*out = *in
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySmokeTestStatus.
+func (in *RegistrySmokeTestStatus) DeepCopy() *RegistrySmokeTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistrySmokeTestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
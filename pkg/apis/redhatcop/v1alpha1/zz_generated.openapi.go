@@ -11,25 +11,66 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.APIToken":                          schema_pkg_apis_redhatcop_v1alpha1_APIToken(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.APITokenStatus":                    schema_pkg_apis_redhatcop_v1alpha1_APITokenStatus(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.AzureRegistryBackendSource":        schema_pkg_apis_redhatcop_v1alpha1_AzureRegistryBackendSource(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Clair":                             schema_pkg_apis_redhatcop_v1alpha1_Clair(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.CloudfrontS3RegistryBackendSource": schema_pkg_apis_redhatcop_v1alpha1_CloudfrontS3RegistryBackendSource(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus":                   schema_pkg_apis_redhatcop_v1alpha1_ComponentStatus(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ConfigFile":                        schema_pkg_apis_redhatcop_v1alpha1_ConfigFile(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ConfigFiles":                       schema_pkg_apis_redhatcop_v1alpha1_ConfigFiles(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Database":                          schema_pkg_apis_redhatcop_v1alpha1_Database(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ExternalAccess":                    schema_pkg_apis_redhatcop_v1alpha1_ExternalAccess(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.GoogleCloudRegistryBackendSource":  schema_pkg_apis_redhatcop_v1alpha1_GoogleCloudRegistryBackendSource(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.HorizontalPodAutoscaler":           schema_pkg_apis_redhatcop_v1alpha1_HorizontalPodAutoscaler(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.LocalRegistryBackendSource":        schema_pkg_apis_redhatcop_v1alpha1_LocalRegistryBackendSource(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Quay":                              schema_pkg_apis_redhatcop_v1alpha1_Quay(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystem":                     schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystem(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemComponentsStatus":     schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystemComponentsStatus(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemCondition":            schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystemCondition(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemSpec":                 schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystemSpec(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemStatus":               schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystemStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotification":                  schema_pkg_apis_redhatcop_v1alpha1_QuayNotification(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationEmail":             schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationEmail(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationSlack":             schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationSlack(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationSpec":              schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationSpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationStatus":            schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationWebhook":           schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationWebhook(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayOrganization":                  schema_pkg_apis_redhatcop_v1alpha1_QuayOrganization(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayOrganizationSpec":              schema_pkg_apis_redhatcop_v1alpha1_QuayOrganizationSpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayOrganizationStatus":            schema_pkg_apis_redhatcop_v1alpha1_QuayOrganizationStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepository":                    schema_pkg_apis_redhatcop_v1alpha1_QuayRepository(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryMirror":              schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryMirror(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryMirrorSpec":          schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryMirrorSpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryMirrorStatus":        schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryMirrorStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryPermission":          schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryPermission(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositorySpec":                schema_pkg_apis_redhatcop_v1alpha1_QuayRepositorySpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryStatus":              schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition":             schema_pkg_apis_redhatcop_v1alpha1_QuayResourceCondition(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceSpec":                  schema_pkg_apis_redhatcop_v1alpha1_QuayResourceSpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceStatus":                schema_pkg_apis_redhatcop_v1alpha1_QuayResourceStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccount":                  schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccount(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountPermission":        schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccountPermission(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountSpec":              schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccountSpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountStatus":            schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccountStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTagRetentionPolicy":            schema_pkg_apis_redhatcop_v1alpha1_QuayTagRetentionPolicy(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTagRetentionPolicySpec":        schema_pkg_apis_redhatcop_v1alpha1_QuayTagRetentionPolicySpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTagRetentionPolicyStatus":      schema_pkg_apis_redhatcop_v1alpha1_QuayTagRetentionPolicyStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeam":                          schema_pkg_apis_redhatcop_v1alpha1_QuayTeam(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamPermission":                schema_pkg_apis_redhatcop_v1alpha1_QuayTeamPermission(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamSpec":                      schema_pkg_apis_redhatcop_v1alpha1_QuayTeamSpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamStatus":                    schema_pkg_apis_redhatcop_v1alpha1_QuayTeamStatus(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamSync":                      schema_pkg_apis_redhatcop_v1alpha1_QuayTeamSync(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayUser":                          schema_pkg_apis_redhatcop_v1alpha1_QuayUser(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayUserSpec":                      schema_pkg_apis_redhatcop_v1alpha1_QuayUserSpec(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayUserStatus":                    schema_pkg_apis_redhatcop_v1alpha1_QuayUserStatus(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RADOSRegistryBackendSource":        schema_pkg_apis_redhatcop_v1alpha1_RADOSRegistryBackendSource(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RHOCSRegistryBackendSource":        schema_pkg_apis_redhatcop_v1alpha1_RHOCSRegistryBackendSource(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Redis":                             schema_pkg_apis_redhatcop_v1alpha1_Redis(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistryBackend":                   schema_pkg_apis_redhatcop_v1alpha1_RegistryBackend(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistryBackendSource":             schema_pkg_apis_redhatcop_v1alpha1_RegistryBackendSource(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistrySmokeTest":                 schema_pkg_apis_redhatcop_v1alpha1_RegistrySmokeTest(ref),
+		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistrySmokeTestStatus":           schema_pkg_apis_redhatcop_v1alpha1_RegistrySmokeTestStatus(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistryStorage":                   schema_pkg_apis_redhatcop_v1alpha1_RegistryStorage(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.S3RegistryBackendSource":           schema_pkg_apis_redhatcop_v1alpha1_S3RegistryBackendSource(ref),
 		"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.SwiftRegistryBackendSource":        schema_pkg_apis_redhatcop_v1alpha1_SwiftRegistryBackendSource(ref),
@@ -37,6 +78,121 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_redhatcop_v1alpha1_APIToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIToken defines the OAuth token for the Quay API minted by the operator on behalf of the initial superuser",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"organization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Organization is the organization administered by the initial superuser that contains the OAuth application of the operator. Defaults to quay-operator",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scopes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Scopes are the permissions granted to the token. Defaults to all scopes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the Secret the token is stored in. Defaults to <name>-quay-api-token",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_APITokenStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APITokenStatus defines the observed state of the OAuth token for the Quay API minted by the operator",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the Secret containing the token",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"organization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Organization is the organization containing the OAuth application the token was issued for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientID is the client ID of the OAuth application the token was issued for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scopes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Scopes are the permissions granted to the token",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"regenerationRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegenerationRequest is the value of the token regeneration annotation last processed by the operator",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTime is the time the token was minted",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"secretName", "organization", "clientID"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_redhatcop_v1alpha1_AzureRegistryBackendSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -221,11 +377,16 @@ func schema_pkg_apis_redhatcop_v1alpha1_Clair(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"horizontalPodAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.HorizontalPodAutoscaler"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ConfigFiles", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Database", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ConfigFiles", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Database", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.HorizontalPodAutoscaler", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -296,6 +457,50 @@ func schema_pkg_apis_redhatcop_v1alpha1_CloudfrontS3RegistryBackendSource(ref co
 	}
 }
 
+func schema_pkg_apis_redhatcop_v1alpha1_ComponentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentStatus defines the observed state of a component deployed by the operator",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"desiredReplicas", "readyReplicas"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_redhatcop_v1alpha1_ConfigFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -656,6 +861,38 @@ func schema_pkg_apis_redhatcop_v1alpha1_GoogleCloudRegistryBackendSource(ref com
 	}
 }
 
+func schema_pkg_apis_redhatcop_v1alpha1_HorizontalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HorizontalPodAutoscaler defines a HorizontalPodAutoscaler that scales a component based on CPU utilization When specified, the operator no longer manages the number of replicas of the component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"targetCPUUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+				Required: []string{"maxReplicas"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_redhatcop_v1alpha1_LocalRegistryBackendSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -964,6 +1201,16 @@ func schema_pkg_apis_redhatcop_v1alpha1_Quay(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"horizontalPodAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.HorizontalPodAutoscaler"),
+						},
+					},
+					"repoMirrorHorizontalPodAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.HorizontalPodAutoscaler"),
+						},
+					},
 					"tolerations": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -981,11 +1228,23 @@ func schema_pkg_apis_redhatcop_v1alpha1_Quay(ref common.ReferenceCallback) commo
 							},
 						},
 					},
+					"apiToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIToken requests an OAuth token for the Quay API to be minted by the operator once setup has completed",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.APIToken"),
+						},
+					},
+					"smokeTest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SmokeTest requests the registry to be verified periodically by pushing, pulling and deleting an image once setup has completed",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistrySmokeTest"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ConfigFiles", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Database", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ExternalAccess", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistryBackend", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistryStorage", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.APIToken", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ConfigFiles", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.Database", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ExternalAccess", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.HorizontalPodAutoscaler", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistryBackend", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistrySmokeTest", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistryStorage", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -1033,6 +1292,56 @@ func schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystem(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystemComponentsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayEcosystemComponentsStatus defines the observed state of each component of the QuayEcosystem",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quay": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"),
+						},
+					},
+					"quayConfig": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"),
+						},
+					},
+					"repoMirror": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"),
+						},
+					},
+					"redis": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"),
+						},
+					},
+					"quayDatabase": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"),
+						},
+					},
+					"clair": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"),
+						},
+					},
+					"clairDatabase": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.ComponentStatus"},
+	}
+}
+
 func schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystemCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1163,85 +1472,2037 @@ func schema_pkg_apis_redhatcop_v1alpha1_QuayEcosystemStatus(ref common.Reference
 							Format: "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemCondition"},
-	}
-}
-
-func schema_pkg_apis_redhatcop_v1alpha1_RADOSRegistryBackendSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RADOSRegistryBackendSource defines Ceph RADOS registry storage",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"storagePath": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"bucketName": {
+					"effectiveSpec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "EffectiveSpec is the QuayEcosystemSpec after defaults have been applied by the operator",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
-					"accessKey": {
+					"components": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Components reports the state of each component managed by the operator",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemComponentsStatus"),
 						},
 					},
-					"secretKey": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Replicas is the number of ready Quay application pods and is reported by the scale subresource",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"hostname": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LabelSelector selects the Quay application pods and is used by the scale subresource",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secure": {
+					"apiToken": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "APIToken reports the OAuth token for the Quay API minted by the operator",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.APITokenStatus"),
 						},
 					},
-					"port": {
+					"smokeTest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "SmokeTest reports the last registry smoke test run by the operator",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistrySmokeTestStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.APITokenStatus", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemComponentsStatus", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayEcosystemCondition", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.RegistrySmokeTestStatus", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_redhatcop_v1alpha1_RHOCSRegistryBackendSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_redhatcop_v1alpha1_QuayNotification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RHOCSRegistryBackendSource defines RHOCS registry storage",
+				Description: "QuayNotification is the Schema for the quaynotifications API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"storagePath": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"bucketName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationSpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationEmail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayNotificationEmail defines the recipient of a notification delivered by email",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address is the email address receiving the notification. Quay only delivers notifications to addresses authorized for the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"address"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationSlack(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayNotificationSlack defines the Slack incoming webhook receiving a notification Either the URL or a Secret containing the URL in its url key must be specified",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the URL of the Slack incoming webhook",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"urlSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URLSecretName is the name of a Secret containing the URL of the Slack incoming webhook",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayNotificationSpec defines the desired state of QuayNotification The configuration matching the method must be specified",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the organization or user in Quay containing the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository is the name of the repository whose events are notified",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Title is the title identifying the notification in Quay. An existing notification with the same title is replaced. Defaults to the name of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"event": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Event is the repository event triggering the notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventConfig filters the events triggering the notification such as the minimum level of vulnerability_found events or the ref-regex of build events",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is how the notification is delivered",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook configures the delivery of the notification to a webhook",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationWebhook"),
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Email configures the delivery of the notification by email",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationEmail"),
+						},
+					},
+					"slack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Slack configures the delivery of the notification to Slack",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationSlack"),
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName", "namespace", "repository", "event", "method"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationEmail", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationSlack", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayNotificationWebhook"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayNotificationStatus defines the observed state of QuayNotification",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"repositoryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryName is the full name of the repository containing the notification in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uuid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UUID is the identifier of the notification in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigChecksum is the checksum of the configuration of the notification last provided to Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"numberOfFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfFailures is the number of consecutive failed deliveries of the notification reported by Quay",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"testRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestRequest is the value of the test annotation last handled",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTestTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTestTime is the time a test notification was last triggered",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayNotificationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayNotificationWebhook defines the webhook receiving a notification Either the URL or a Secret containing the URL in its url key must be specified",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the URL receiving the notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"urlSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URLSecretName is the name of a Secret containing the URL receiving the notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bodyTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BodyTemplate is a JSON template for the body of the request replacing the default payload of the event",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayOrganization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayOrganization is the Schema for the quayorganizations API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayOrganizationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayOrganizationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayOrganizationSpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayOrganizationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayOrganizationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayOrganizationSpec defines the desired state of QuayOrganization",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the organization in Quay. Defaults to the name of the QuayOrganization",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Email is the email address associated with the organization",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayOrganizationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayOrganizationStatus defines the observed state of QuayOrganization",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"organizationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrganizationName is the name of the organization managed in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRepository(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRepository is the Schema for the quayrepositories API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositorySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositorySpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRepositoryMirror is the Schema for the quayrepositorymirrors API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryMirrorSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryMirrorStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryMirrorSpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryMirrorStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryMirrorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRepositoryMirrorSpec defines the desired state of QuayRepositoryMirror",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the organization or user in Quay containing the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository is the name of the existing repository in Quay that is mirrored",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalReference is the location of the repository mirrored from an external registry such as quay.io/coreos/etcd",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tagPatterns": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TagPatterns are the patterns matching the tags that are mirrored such as latest or v3.*",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"syncInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncInterval is the interval between synchronizations of the repository. Defaults to 24h",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"robotAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RobotAccount is the robot account used to push the mirrored images. Robot accounts can be referenced by their short name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecretName is the name of a Secret containing the username and password used to access the external registry",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verifyTLS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerifyTLS determines whether the certificate of the external registry is verified. Defaults to true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend disables the scheduled synchronization of the repository",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName", "namespace", "repository", "externalReference", "tagPatterns", "robotAccount"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryMirrorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRepositoryMirrorStatus defines the observed state of QuayRepositoryMirror",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"repositoryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryName is the full name of the mirrored repository in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirrorSyncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorSyncStatus is the state of the latest synchronization of the repository reported by Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastMirrorSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastMirrorSyncTime is the time the operator observed the completion of the latest synchronization of the repository",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextMirrorSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextMirrorSyncTime is the time of the next scheduled synchronization of the repository",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"credentialsChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsChecksum is the checksum of the credentials last provided to Quay for the external registry",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryPermission(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRepositoryPermission defines the access granted on a repository to a user, team or robot account",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the user, team or robot account. Robot accounts can be referenced by their short name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role is the access granted on the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "role"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRepositorySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRepositorySpec defines the desired state of QuayRepository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the organization or user in Quay containing the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the repository in Quay. Defaults to the name of the QuayRepository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"visibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Visibility determines who can pull from the repository. Defaults to private",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the description of the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userPermissions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "UserPermissions are the permissions granted to users. Permissions of users are not managed when omitted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryPermission"),
+									},
+								},
+							},
+						},
+					},
+					"teamPermissions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TeamPermissions are the permissions granted to teams of the organization. Permissions of teams are not managed when omitted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryPermission"),
+									},
+								},
+							},
+						},
+					},
+					"robotPermissions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RobotPermissions are the permissions granted to robot accounts. Permissions of robot accounts are not managed when omitted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryPermission"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName", "namespace"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRepositoryPermission"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRepositoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRepositoryStatus defines the observed state of QuayRepository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"repositoryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryName is the full name of the repository managed in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayResourceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayResourceCondition defines a condition reported by a resource managed through the Quay API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"status", "type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayResourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayResourceSpec defines the properties shared by resources managed through the Quay API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayResourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayResourceStatus defines the observed state shared by resources managed through the Quay API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRobotAccount is the Schema for the quayrobotaccounts API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountSpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccountPermission(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRobotAccountPermission defines the access granted to a robot account on a repository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository is the name of the repository within the organization",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role is the access granted on the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"repository", "role"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccountSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRobotAccountSpec defines the desired state of QuayRobotAccount",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"organization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Organization is the organization in Quay containing the robot account",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the short name of the robot account in Quay. Defaults to the name of the QuayRobotAccount with dashes and dots replaced by underscores",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the description of the robot account set when it is created",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repositoryPermissions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryPermissions are the permissions granted to the robot account on repositories of the organization. Permissions are not managed when omitted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountPermission"),
+									},
+								},
+							},
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the Secret containing the credentials of the robot account. Defaults to the name of the QuayRobotAccount",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName", "organization"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayRobotAccountPermission"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayRobotAccountStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayRobotAccountStatus defines the observed state of QuayRobotAccount",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"robotAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RobotAccountName is the full name of the robot account managed in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the Secret containing the credentials of the robot account",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenRegenerationRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRegenerationRequest is the value of the token regeneration annotation that was last processed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTagRetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTagRetentionPolicy is the Schema for the quaytagretentionpolicies API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTagRetentionPolicySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTagRetentionPolicyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTagRetentionPolicySpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTagRetentionPolicyStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTagRetentionPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTagRetentionPolicySpec defines the desired state of QuayTagRetentionPolicy The KeepLast most recent tags of each repository are retained regardless of their age. The remaining tags are pruned when they are older than MaxAge, or unconditionally when MaxAge is not specified. Tags matching ProtectedTagPattern are never pruned and do not count towards KeepLast",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"organization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Organization is the organization in Quay containing the repositories the policy applies to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repositoryPattern": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryPattern is a shell pattern such as ci-* matching the names of the repositories the policy applies to. Defaults to all repositories",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keepLast": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeepLast is the number of most recent tags of each repository that are retained",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the age after which tags beyond the KeepLast most recent tags are pruned",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"protectedTagPattern": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProtectedTagPattern is a regular expression matching the tags that are never pruned such as ^(latest|v[0-9.]+)$",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the interval between runs of the policy. Defaults to 1h",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun reports the tags that would be pruned in the status without deleting them",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName", "organization"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTagRetentionPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTagRetentionPolicyStatus defines the observed state of QuayTagRetentionPolicy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"lastRunTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunTime is the time of the latest run of the policy",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextRunTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextRunTime is the time of the next scheduled run of the policy",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun indicates whether the latest run only reported the tags that would be pruned",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"prunedTagCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrunedTagCount is the number of tags pruned by the latest run, or that would be pruned in dry-run mode",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"prunedTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PrunedTags lists the first tags pruned by the latest run, or that would be pruned in dry-run mode, in the form repository:tag",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTeam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTeam is the Schema for the quayteams API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamSpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTeamPermission(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTeamPermission defines the access granted to a team on a repository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository is the name of the repository within the organization",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role is the access granted on the repository",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"repository", "role"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTeamSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTeamSpec defines the desired state of QuayTeam",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"organization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Organization is the organization in Quay containing the team",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the team in Quay. Defaults to the name of the QuayTeam",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role is the access granted to the members of the team on the organization. Defaults to member",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the description of the team",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"users": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Users are the users that are members of the team. Must be omitted when the team is synchronized with a group",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"robots": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Robots are the robot accounts that are members of the team. Robot accounts can be referenced by their short name",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"repositoryPermissions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryPermissions are the permissions granted to the team on repositories of the organization. Permissions are not managed when omitted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamPermission"),
+									},
+								},
+							},
+						},
+					},
+					"sync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sync binds the membership of the team to a group of the external authentication provider of Quay",
+							Ref:         ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamSync"),
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName", "organization"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamPermission", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayTeamSync"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTeamStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTeamStatus defines the observed state of QuayTeam",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"teamName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TeamName is the full name of the team managed in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncService is the external authentication provider the team is synchronized with",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayTeamSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayTeamSync defines the group of the external authentication provider the team is synchronized with",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group identifies the group such as the distinguished name of an LDAP group or the name of an OIDC group",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"group"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayUser(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayUser is the Schema for the quayusers API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayUserSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayUserStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayUserSpec", "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayUserStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayUserSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayUserSpec defines the desired state of QuayUser Users are managed through the superuser API which is only available when Quay uses database authentication",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quayEcosystemName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuayEcosystemName is the name of the QuayEcosystem in the same namespace providing the Quay instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy determines whether the object is deleted from Quay when the resource is deleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the name of the user in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Email is the email address of the user",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"passwordSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PasswordSecretName is the name of a Secret containing the initial password of the user in its password key The password is only set when the user is created. Without it the password generated by Quay must be recovered by email",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled prevents the user from signing in",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"quayEcosystemName", "username", "email"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_QuayUserStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuayUserStatus defines the observed state of QuayUser",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation of the specification processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSyncTime is the last time the object was successfully synchronized with Quay",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type":       "atomic",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition"),
+									},
+								},
+							},
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the name of the user managed in Quay",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the user is allowed to sign in",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1.QuayResourceCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_RADOSRegistryBackendSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RADOSRegistryBackendSource defines Ceph RADOS registry storage",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storagePath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"bucketName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"accessKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secretKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"hostname": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secure": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_RHOCSRegistryBackendSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RHOCSRegistryBackendSource defines RHOCS registry storage",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storagePath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"bucketName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
@@ -1535,6 +3796,61 @@ func schema_pkg_apis_redhatcop_v1alpha1_RegistryBackendSource(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_redhatcop_v1alpha1_RegistrySmokeTest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistrySmokeTest defines the smoke test verifying the registry on behalf of the initial superuser",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"organization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Organization is the organization dedicated to the smoke test which is created when it does not exist. Defaults to quay-operator-smoke-test",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the duration between runs of the smoke test, for example 30m. Defaults to 1h",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_redhatcop_v1alpha1_RegistrySmokeTestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistrySmokeTestStatus defines the observed state of the registry smoke test",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastRunTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunTime is the time the smoke test last ran",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the QuayEcosystem the smoke test last ran for",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_redhatcop_v1alpha1_RegistryStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1722,6 +4038,13 @@ func schema_pkg_apis_redhatcop_v1alpha1_TLSExternalAccess(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"insecureSkipVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureSkipVerify disables the verification of the certificates presented by Quay and its config app to the operator Certificates are otherwise verified against the system roots, the certificates of Quay and Clair and the extraCaCert config files",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"termination"},
 			},
@@ -0,0 +1,954 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// InlineContentAnnotation preserves the inline credentials and configuration file content of a v1alpha1 QuayEcosystem
+// which cannot be represented in v1alpha2 so they are restored when the QuayEcosystem is converted back
+const InlineContentAnnotation = "redhatcop.redhat.io/v1alpha1-inline-content"
+
+// ConvertTo converts this QuayEcosystem to the Hub version (v1alpha1)
+func (src *QuayEcosystem) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*redhatcopv1alpha1.QuayEcosystem)
+
+	in := src.DeepCopy()
+
+	dst.ObjectMeta = in.ObjectMeta
+
+	if in.Spec.Quay != nil {
+		dst.Spec.Quay = convertQuayTo(in.Spec.Quay)
+	}
+
+	if in.Spec.Redis != nil {
+		redis := redhatcopv1alpha1.Redis(*in.Spec.Redis)
+		dst.Spec.Redis = &redis
+	}
+
+	if in.Spec.Clair != nil {
+		dst.Spec.Clair = convertClairTo(in.Spec.Clair)
+	}
+
+	dst.Status = convertStatusTo(&in.Status)
+
+	return restoreInlineContent(dst)
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version
+// Inline credentials and configuration file content cannot be represented in v1alpha2 so they are preserved in the
+// InlineContentAnnotation rather than failing the conversion, which would prevent every v1alpha2 read
+func (dst *QuayEcosystem) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*redhatcopv1alpha1.QuayEcosystem).DeepCopy()
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	if src.Spec.Quay != nil {
+		dst.Spec.Quay = convertQuayFrom(src.Spec.Quay)
+	}
+
+	if src.Spec.Redis != nil {
+		redis := Redis(*src.Spec.Redis)
+		dst.Spec.Redis = &redis
+	}
+
+	if src.Spec.Clair != nil {
+		dst.Spec.Clair = convertClairFrom(src.Spec.Clair)
+	}
+
+	dst.Status = convertStatusFrom(&src.Status)
+
+	return preserveInlineContent(src, dst)
+}
+
+func convertQuayTo(in *Quay) *redhatcopv1alpha1.Quay {
+	out := &redhatcopv1alpha1.Quay{
+		DeploymentStrategy:  in.DeploymentStrategy,
+		Image:               in.Image,
+		ImagePullSecretName: in.ImagePullSecretName,
+		NodeSelector:        in.NodeSelector,
+		SecurityContext:     in.SecurityContext,
+		MigrationPhase:      redhatcopv1alpha1.QuayMigrationPhase(in.MigrationPhase),
+		ConfigFiles:         convertConfigFilesTo(in.ConfigFiles),
+		ExternalAccess:      convertExternalAccessTo(in.ExternalAccess),
+	}
+
+	if in.Database != nil {
+		database := redhatcopv1alpha1.Database(*in.Database)
+		out.Database = &database
+	}
+
+	if in.App != nil {
+		out.EnvVars = in.App.EnvVars
+		out.LivenessProbe = in.App.LivenessProbe
+		out.ReadinessProbe = in.App.ReadinessProbe
+		out.Replicas = in.App.Replicas
+		out.Resources = in.App.Resources
+		out.Tolerations = in.App.Tolerations
+		out.HorizontalPodAutoscaler = convertHorizontalPodAutoscalerTo(in.App.HorizontalPodAutoscaler)
+	}
+
+	if in.Config != nil {
+		out.ConfigEnvVars = in.Config.EnvVars
+		out.KeepConfigDeployment = in.Config.KeepDeployment
+		out.ConfigResources = in.Config.Resources
+		out.ConfigSecretName = in.Config.SecretName
+		out.ConfigTolerations = in.Config.Tolerations
+	}
+
+	if in.RepoMirror != nil {
+		out.EnableRepoMirroring = in.RepoMirror.Enabled
+		out.RepoMirrorEnvVars = in.RepoMirror.EnvVars
+		out.MirrorReplicas = in.RepoMirror.Replicas
+		out.RepoMirrorResources = in.RepoMirror.Resources
+		out.RepoMirrorServerHostname = in.RepoMirror.ServerHostname
+		out.RepoMirrorTLSVerify = in.RepoMirror.TLSVerify
+		out.RepoMirrorTolerations = in.RepoMirror.Tolerations
+		out.RepoMirrorHorizontalPodAutoscaler = convertHorizontalPodAutoscalerTo(in.RepoMirror.HorizontalPodAutoscaler)
+	}
+
+	if in.Setup != nil {
+		out.SkipSetup = in.Setup.Skip
+		out.SuperuserCredentialsSecretName = in.Setup.SuperuserCredentialsSecretName
+		out.Superusers = in.Setup.Superusers
+		out.APIToken = convertAPITokenTo(in.Setup.APIToken)
+	}
+
+	if in.Storage != nil {
+		out.EnableStorageReplication = in.Storage.EnableReplication
+		out.RegistryStorage = convertRegistryStorageTo(in.Storage.PersistentVolume)
+		out.RegistryBackends = convertRegistryBackendsTo(in.Storage.Backends)
+	}
+
+	if in.SmokeTest != nil {
+		smokeTest := redhatcopv1alpha1.RegistrySmokeTest(*in.SmokeTest)
+		out.SmokeTest = &smokeTest
+	}
+
+	return out
+}
+
+func convertQuayFrom(in *redhatcopv1alpha1.Quay) *Quay {
+	out := &Quay{
+		DeploymentStrategy:  in.DeploymentStrategy,
+		Image:               in.Image,
+		ImagePullSecretName: in.ImagePullSecretName,
+		NodeSelector:        in.NodeSelector,
+		SecurityContext:     in.SecurityContext,
+		MigrationPhase:      QuayMigrationPhase(in.MigrationPhase),
+		ConfigFiles:         convertConfigFilesFrom(in.ConfigFiles),
+		ExternalAccess:      convertExternalAccessFrom(in.ExternalAccess),
+	}
+
+	if in.Database != nil {
+		database := Database(*in.Database)
+		out.Database = &database
+	}
+
+	if in.EnvVars != nil || in.LivenessProbe != nil || in.ReadinessProbe != nil || in.Replicas != nil ||
+		!isEmptyResources(in.Resources) || in.Tolerations != nil || in.HorizontalPodAutoscaler != nil {
+		out.App = &QuayApp{
+			EnvVars:                 in.EnvVars,
+			LivenessProbe:           in.LivenessProbe,
+			ReadinessProbe:          in.ReadinessProbe,
+			Replicas:                in.Replicas,
+			Resources:               in.Resources,
+			Tolerations:             in.Tolerations,
+			HorizontalPodAutoscaler: convertHorizontalPodAutoscalerFrom(in.HorizontalPodAutoscaler),
+		}
+	}
+
+	if in.ConfigEnvVars != nil || in.KeepConfigDeployment != nil || in.ConfigSecretName != "" ||
+		!isEmptyResources(in.ConfigResources) || in.ConfigTolerations != nil {
+		out.Config = &QuayConfig{
+			EnvVars:        in.ConfigEnvVars,
+			KeepDeployment: in.KeepConfigDeployment,
+			Resources:      in.ConfigResources,
+			SecretName:     in.ConfigSecretName,
+			Tolerations:    in.ConfigTolerations,
+		}
+	}
+
+	if in.EnableRepoMirroring || in.RepoMirrorEnvVars != nil || in.MirrorReplicas != nil || in.RepoMirrorServerHostname != "" ||
+		in.RepoMirrorTLSVerify || !isEmptyResources(in.RepoMirrorResources) || in.RepoMirrorTolerations != nil || in.RepoMirrorHorizontalPodAutoscaler != nil {
+		out.RepoMirror = &QuayRepoMirror{
+			Enabled:                 in.EnableRepoMirroring,
+			EnvVars:                 in.RepoMirrorEnvVars,
+			Replicas:                in.MirrorReplicas,
+			Resources:               in.RepoMirrorResources,
+			ServerHostname:          in.RepoMirrorServerHostname,
+			TLSVerify:               in.RepoMirrorTLSVerify,
+			Tolerations:             in.RepoMirrorTolerations,
+			HorizontalPodAutoscaler: convertHorizontalPodAutoscalerFrom(in.RepoMirrorHorizontalPodAutoscaler),
+		}
+	}
+
+	if in.SkipSetup || in.SuperuserCredentialsSecretName != "" || in.Superusers != nil || in.APIToken != nil {
+		out.Setup = &QuaySetup{
+			Skip:                           in.SkipSetup,
+			SuperuserCredentialsSecretName: in.SuperuserCredentialsSecretName,
+			Superusers:                     in.Superusers,
+			APIToken:                       convertAPITokenFrom(in.APIToken),
+		}
+	}
+
+	if in.EnableStorageReplication || in.RegistryStorage != nil || in.RegistryBackends != nil {
+		out.Storage = &QuayStorage{
+			EnableReplication: in.EnableStorageReplication,
+			PersistentVolume:  convertRegistryStorageFrom(in.RegistryStorage),
+			Backends:          convertRegistryBackendsFrom(in.RegistryBackends),
+		}
+	}
+
+	if in.SmokeTest != nil {
+		smokeTest := RegistrySmokeTest(*in.SmokeTest)
+		out.SmokeTest = &smokeTest
+	}
+
+	return out
+}
+
+func convertClairTo(in *Clair) *redhatcopv1alpha1.Clair {
+	out := &redhatcopv1alpha1.Clair{
+		DeploymentStrategy:        in.DeploymentStrategy,
+		Enabled:                   in.Enabled,
+		EnvVars:                   in.EnvVars,
+		Image:                     in.Image,
+		ImagePullSecretName:       in.ImagePullSecretName,
+		LivenessProbe:             in.LivenessProbe,
+		NodeSelector:              in.NodeSelector,
+		ReadinessProbe:            in.ReadinessProbe,
+		Replicas:                  in.Replicas,
+		Resources:                 in.Resources,
+		SslCertificatesSecretName: in.SslCertificatesSecretName,
+		UpdateInterval:            in.UpdateInterval,
+		SecurityContext:           in.SecurityContext,
+		ConfigFiles:               convertConfigFilesTo(in.ConfigFiles),
+		Tolerations:               in.Tolerations,
+		HorizontalPodAutoscaler:   convertHorizontalPodAutoscalerTo(in.HorizontalPodAutoscaler),
+	}
+
+	if in.Database != nil {
+		database := redhatcopv1alpha1.Database(*in.Database)
+		out.Database = &database
+	}
+
+	return out
+}
+
+func convertClairFrom(in *redhatcopv1alpha1.Clair) *Clair {
+	out := &Clair{
+		DeploymentStrategy:        in.DeploymentStrategy,
+		Enabled:                   in.Enabled,
+		EnvVars:                   in.EnvVars,
+		Image:                     in.Image,
+		ImagePullSecretName:       in.ImagePullSecretName,
+		LivenessProbe:             in.LivenessProbe,
+		NodeSelector:              in.NodeSelector,
+		ReadinessProbe:            in.ReadinessProbe,
+		Replicas:                  in.Replicas,
+		Resources:                 in.Resources,
+		SslCertificatesSecretName: in.SslCertificatesSecretName,
+		UpdateInterval:            in.UpdateInterval,
+		SecurityContext:           in.SecurityContext,
+		ConfigFiles:               convertConfigFilesFrom(in.ConfigFiles),
+		Tolerations:               in.Tolerations,
+		HorizontalPodAutoscaler:   convertHorizontalPodAutoscalerFrom(in.HorizontalPodAutoscaler),
+	}
+
+	if in.Database != nil {
+		database := Database(*in.Database)
+		out.Database = &database
+	}
+
+	return out
+}
+
+func convertExternalAccessTo(in *ExternalAccess) *redhatcopv1alpha1.ExternalAccess {
+	if in == nil {
+		return nil
+	}
+
+	out := &redhatcopv1alpha1.ExternalAccess{
+		Annotations:       in.Annotations,
+		ConfigAnnotations: in.ConfigAnnotations,
+		ConfigHostname:    in.ConfigHostname,
+		ConfigNodePort:    in.ConfigNodePort,
+		Hostname:          in.Hostname,
+		NodePort:          in.NodePort,
+		Type:              redhatcopv1alpha1.ExternalAccessType(in.Type),
+	}
+
+	if in.TLS != nil {
+		out.TLS = &redhatcopv1alpha1.TLSExternalAccess{
+			SecretName:         in.TLS.SecretName,
+			Termination:        redhatcopv1alpha1.TLSTerminationType(in.TLS.Termination),
+			InsecureSkipVerify: in.TLS.InsecureSkipVerify,
+		}
+	}
+
+	return out
+}
+
+func convertExternalAccessFrom(in *redhatcopv1alpha1.ExternalAccess) *ExternalAccess {
+	if in == nil {
+		return nil
+	}
+
+	out := &ExternalAccess{
+		Annotations:       in.Annotations,
+		ConfigAnnotations: in.ConfigAnnotations,
+		ConfigHostname:    in.ConfigHostname,
+		ConfigNodePort:    in.ConfigNodePort,
+		Hostname:          in.Hostname,
+		NodePort:          in.NodePort,
+		Type:              ExternalAccessType(in.Type),
+	}
+
+	if in.TLS != nil {
+		out.TLS = &TLSExternalAccess{
+			SecretName:         in.TLS.SecretName,
+			Termination:        TLSTerminationType(in.TLS.Termination),
+			InsecureSkipVerify: in.TLS.InsecureSkipVerify,
+		}
+	}
+
+	return out
+}
+
+func convertHorizontalPodAutoscalerTo(in *HorizontalPodAutoscaler) *redhatcopv1alpha1.HorizontalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+
+	out := redhatcopv1alpha1.HorizontalPodAutoscaler(*in)
+	return &out
+}
+
+func convertHorizontalPodAutoscalerFrom(in *redhatcopv1alpha1.HorizontalPodAutoscaler) *HorizontalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+
+	out := HorizontalPodAutoscaler(*in)
+	return &out
+}
+
+func convertRegistryStorageTo(in *RegistryStorage) *redhatcopv1alpha1.RegistryStorage {
+	if in == nil {
+		return nil
+	}
+
+	out := redhatcopv1alpha1.RegistryStorage(*in)
+	return &out
+}
+
+func convertRegistryStorageFrom(in *redhatcopv1alpha1.RegistryStorage) *RegistryStorage {
+	if in == nil {
+		return nil
+	}
+
+	out := RegistryStorage(*in)
+	return &out
+}
+
+// convertRegistryBackendsTo converts registry backends to v1alpha1, whose credentials are read from CredentialsSecretName
+func convertRegistryBackendsTo(in []RegistryBackend) []redhatcopv1alpha1.RegistryBackend {
+	if in == nil {
+		return nil
+	}
+
+	out := []redhatcopv1alpha1.RegistryBackend{}
+
+	for _, backend := range in {
+		outBackend := redhatcopv1alpha1.RegistryBackend{
+			Name:                  backend.Name,
+			CredentialsSecretName: backend.CredentialsSecretName,
+			ReplicateByDefault:    backend.ReplicateByDefault,
+		}
+
+		source := &outBackend.RegistryBackendSource
+
+		if backend.Local != nil {
+			local := redhatcopv1alpha1.LocalRegistryBackendSource(*backend.Local)
+			source.Local = &local
+		}
+
+		if backend.S3 != nil {
+			source.S3 = &redhatcopv1alpha1.S3RegistryBackendSource{
+				StoragePath: backend.S3.StoragePath,
+				BucketName:  backend.S3.BucketName,
+				Host:        backend.S3.Host,
+				Port:        backend.S3.Port,
+			}
+		}
+
+		if backend.GoogleCloud != nil {
+			source.GoogleCloud = &redhatcopv1alpha1.GoogleCloudRegistryBackendSource{
+				StoragePath: backend.GoogleCloud.StoragePath,
+				BucketName:  backend.GoogleCloud.BucketName,
+			}
+		}
+
+		if backend.Azure != nil {
+			source.Azure = &redhatcopv1alpha1.AzureRegistryBackendSource{
+				StoragePath:   backend.Azure.StoragePath,
+				ContainerName: backend.Azure.ContainerName,
+			}
+		}
+
+		if backend.RADOS != nil {
+			source.RADOS = &redhatcopv1alpha1.RADOSRegistryBackendSource{
+				StoragePath: backend.RADOS.StoragePath,
+				BucketName:  backend.RADOS.BucketName,
+				Hostname:    backend.RADOS.Hostname,
+				Secure:      backend.RADOS.Secure,
+				Port:        backend.RADOS.Port,
+			}
+		}
+
+		if backend.RHOCS != nil {
+			source.RHOCS = &redhatcopv1alpha1.RHOCSRegistryBackendSource{
+				StoragePath: backend.RHOCS.StoragePath,
+				BucketName:  backend.RHOCS.BucketName,
+				Hostname:    backend.RHOCS.Hostname,
+				Secure:      backend.RHOCS.Secure,
+				Port:        backend.RHOCS.Port,
+			}
+		}
+
+		if backend.Swift != nil {
+			source.Swift = &redhatcopv1alpha1.SwiftRegistryBackendSource{
+				StoragePath: backend.Swift.StoragePath,
+				AuthVersion: backend.Swift.AuthVersion,
+				AuthURL:     backend.Swift.AuthURL,
+				Container:   backend.Swift.Container,
+				CACertPath:  backend.Swift.CACertPath,
+				TempURLKey:  backend.Swift.TempURLKey,
+				OSOptions:   backend.Swift.OSOptions,
+			}
+		}
+
+		if backend.CloudfrontS3 != nil {
+			source.CloudfrontS3 = &redhatcopv1alpha1.CloudfrontS3RegistryBackendSource{
+				StoragePath:        backend.CloudfrontS3.StoragePath,
+				BucketName:         backend.CloudfrontS3.BucketName,
+				Host:               backend.CloudfrontS3.Host,
+				Port:               backend.CloudfrontS3.Port,
+				DistributionDomain: backend.CloudfrontS3.DistributionDomain,
+				KeyID:              backend.CloudfrontS3.KeyID,
+				PrivateKeyFilename: backend.CloudfrontS3.PrivateKeyFilename,
+			}
+		}
+
+		out = append(out, outBackend)
+	}
+
+	return out
+}
+
+// convertRegistryBackendsFrom converts registry backends from v1alpha1 without their inline credentials
+// as v1alpha2 only references them through CredentialsSecretName
+func convertRegistryBackendsFrom(in []redhatcopv1alpha1.RegistryBackend) []RegistryBackend {
+	if in == nil {
+		return nil
+	}
+
+	out := []RegistryBackend{}
+
+	for _, backend := range in {
+		outBackend := RegistryBackend{
+			Name:                  backend.Name,
+			CredentialsSecretName: backend.CredentialsSecretName,
+			ReplicateByDefault:    backend.ReplicateByDefault,
+		}
+
+		source := &outBackend.RegistryBackendSource
+
+		if backend.Local != nil {
+			local := LocalRegistryBackendSource(*backend.Local)
+			source.Local = &local
+		}
+
+		if backend.S3 != nil {
+			source.S3 = &S3RegistryBackendSource{
+				StoragePath: backend.S3.StoragePath,
+				BucketName:  backend.S3.BucketName,
+				Host:        backend.S3.Host,
+				Port:        backend.S3.Port,
+			}
+		}
+
+		if backend.GoogleCloud != nil {
+			source.GoogleCloud = &GoogleCloudRegistryBackendSource{
+				StoragePath: backend.GoogleCloud.StoragePath,
+				BucketName:  backend.GoogleCloud.BucketName,
+			}
+		}
+
+		if backend.Azure != nil {
+			source.Azure = &AzureRegistryBackendSource{
+				StoragePath:   backend.Azure.StoragePath,
+				ContainerName: backend.Azure.ContainerName,
+			}
+		}
+
+		if backend.RADOS != nil {
+			source.RADOS = &RADOSRegistryBackendSource{
+				StoragePath: backend.RADOS.StoragePath,
+				BucketName:  backend.RADOS.BucketName,
+				Hostname:    backend.RADOS.Hostname,
+				Secure:      backend.RADOS.Secure,
+				Port:        backend.RADOS.Port,
+			}
+		}
+
+		if backend.RHOCS != nil {
+			source.RHOCS = &RHOCSRegistryBackendSource{
+				StoragePath: backend.RHOCS.StoragePath,
+				BucketName:  backend.RHOCS.BucketName,
+				Hostname:    backend.RHOCS.Hostname,
+				Secure:      backend.RHOCS.Secure,
+				Port:        backend.RHOCS.Port,
+			}
+		}
+
+		if backend.Swift != nil {
+			source.Swift = &SwiftRegistryBackendSource{
+				StoragePath: backend.Swift.StoragePath,
+				AuthVersion: backend.Swift.AuthVersion,
+				AuthURL:     backend.Swift.AuthURL,
+				Container:   backend.Swift.Container,
+				CACertPath:  backend.Swift.CACertPath,
+				TempURLKey:  backend.Swift.TempURLKey,
+				OSOptions:   backend.Swift.OSOptions,
+			}
+		}
+
+		if backend.CloudfrontS3 != nil {
+			source.CloudfrontS3 = &CloudfrontS3RegistryBackendSource{
+				StoragePath:        backend.CloudfrontS3.StoragePath,
+				BucketName:         backend.CloudfrontS3.BucketName,
+				Host:               backend.CloudfrontS3.Host,
+				Port:               backend.CloudfrontS3.Port,
+				DistributionDomain: backend.CloudfrontS3.DistributionDomain,
+				KeyID:              backend.CloudfrontS3.KeyID,
+				PrivateKeyFilename: backend.CloudfrontS3.PrivateKeyFilename,
+			}
+		}
+
+		out = append(out, outBackend)
+	}
+
+	return out
+}
+
+// hasInlineCredentials determines whether credentials are specified directly in the v1alpha1 registry backend source
+func hasInlineCredentials(source redhatcopv1alpha1.RegistryBackendSource) bool {
+	return (source.S3 != nil && (source.S3.AccessKey != "" || source.S3.SecretKey != "")) ||
+		(source.GoogleCloud != nil && (source.GoogleCloud.AccessKey != "" || source.GoogleCloud.SecretKey != "")) ||
+		(source.Azure != nil && (source.Azure.AccountName != "" || source.Azure.AccountKey != "" || source.Azure.SasToken != "")) ||
+		(source.RADOS != nil && (source.RADOS.AccessKey != "" || source.RADOS.SecretKey != "")) ||
+		(source.RHOCS != nil && (source.RHOCS.AccessKey != "" || source.RHOCS.SecretKey != "")) ||
+		(source.Swift != nil && (source.Swift.User != "" || source.Swift.Password != "")) ||
+		(source.CloudfrontS3 != nil && (source.CloudfrontS3.AccessKey != "" || source.CloudfrontS3.SecretKey != ""))
+}
+
+// inlineContent holds the v1alpha1 content of a QuayEcosystem which cannot be represented in v1alpha2
+type inlineContent struct {
+	RegistryBackends []redhatcopv1alpha1.RegistryBackend `json:"registryBackends,omitempty"`
+	QuayConfigFiles  []redhatcopv1alpha1.ConfigFiles     `json:"quayConfigFiles,omitempty"`
+	ClairConfigFiles []redhatcopv1alpha1.ConfigFiles     `json:"clairConfigFiles,omitempty"`
+}
+
+// preserveInlineContent stores the inline credentials and configuration file content of the v1alpha1 QuayEcosystem
+// in the InlineContentAnnotation of the converted QuayEcosystem
+func preserveInlineContent(src *redhatcopv1alpha1.QuayEcosystem, dst *QuayEcosystem) error {
+
+	content := inlineContent{}
+
+	if src.Spec.Quay != nil {
+		for _, backend := range src.Spec.Quay.RegistryBackends {
+			if hasInlineCredentials(backend.RegistryBackendSource) {
+				content.RegistryBackends = append(content.RegistryBackends, backend)
+			}
+		}
+		content.QuayConfigFiles = getInlineConfigFiles(src.Spec.Quay.ConfigFiles)
+	}
+
+	if src.Spec.Clair != nil {
+		content.ClairConfigFiles = getInlineConfigFiles(src.Spec.Clair.ConfigFiles)
+	}
+
+	if content.RegistryBackends == nil && content.QuayConfigFiles == nil && content.ClairConfigFiles == nil {
+		return nil
+	}
+
+	value, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[InlineContentAnnotation] = string(value)
+
+	return nil
+}
+
+// restoreInlineContent moves the content preserved in the InlineContentAnnotation back into the v1alpha1 QuayEcosystem
+// Content is matched by the name of the registry backend and the secret and key of the configuration file
+func restoreInlineContent(dst *redhatcopv1alpha1.QuayEcosystem) error {
+
+	value, found := dst.Annotations[InlineContentAnnotation]
+	if !found {
+		return nil
+	}
+
+	delete(dst.Annotations, InlineContentAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	content := inlineContent{}
+	if err := json.Unmarshal([]byte(value), &content); err != nil {
+		return fmt.Errorf("Failed to parse annotation %s: %s", InlineContentAnnotation, err.Error())
+	}
+
+	if dst.Spec.Quay != nil {
+		for i := range dst.Spec.Quay.RegistryBackends {
+			for _, backend := range content.RegistryBackends {
+				if backend.Name == dst.Spec.Quay.RegistryBackends[i].Name {
+					restoreInlineCredentials(&dst.Spec.Quay.RegistryBackends[i].RegistryBackendSource, backend.RegistryBackendSource)
+				}
+			}
+		}
+		restoreInlineConfigFiles(dst.Spec.Quay.ConfigFiles, content.QuayConfigFiles)
+	}
+
+	if dst.Spec.Clair != nil {
+		restoreInlineConfigFiles(dst.Spec.Clair.ConfigFiles, content.ClairConfigFiles)
+	}
+
+	return nil
+}
+
+// restoreInlineCredentials copies the inline credentials of the preserved v1alpha1 registry backend source
+func restoreInlineCredentials(out *redhatcopv1alpha1.RegistryBackendSource, in redhatcopv1alpha1.RegistryBackendSource) {
+	if out.S3 != nil && in.S3 != nil {
+		out.S3.AccessKey, out.S3.SecretKey = in.S3.AccessKey, in.S3.SecretKey
+	}
+	if out.GoogleCloud != nil && in.GoogleCloud != nil {
+		out.GoogleCloud.AccessKey, out.GoogleCloud.SecretKey = in.GoogleCloud.AccessKey, in.GoogleCloud.SecretKey
+	}
+	if out.Azure != nil && in.Azure != nil {
+		out.Azure.AccountName, out.Azure.AccountKey, out.Azure.SasToken = in.Azure.AccountName, in.Azure.AccountKey, in.Azure.SasToken
+	}
+	if out.RADOS != nil && in.RADOS != nil {
+		out.RADOS.AccessKey, out.RADOS.SecretKey = in.RADOS.AccessKey, in.RADOS.SecretKey
+	}
+	if out.RHOCS != nil && in.RHOCS != nil {
+		out.RHOCS.AccessKey, out.RHOCS.SecretKey = in.RHOCS.AccessKey, in.RHOCS.SecretKey
+	}
+	if out.Swift != nil && in.Swift != nil {
+		out.Swift.User, out.Swift.Password = in.Swift.User, in.Swift.Password
+	}
+	if out.CloudfrontS3 != nil && in.CloudfrontS3 != nil {
+		out.CloudfrontS3.AccessKey, out.CloudfrontS3.SecretKey = in.CloudfrontS3.AccessKey, in.CloudfrontS3.SecretKey
+	}
+}
+
+// getInlineConfigFiles returns the v1alpha1 configuration files containing inline content
+func getInlineConfigFiles(in []redhatcopv1alpha1.ConfigFiles) []redhatcopv1alpha1.ConfigFiles {
+
+	var out []redhatcopv1alpha1.ConfigFiles
+
+	for _, configFiles := range in {
+		files := []redhatcopv1alpha1.ConfigFile{}
+
+		for _, file := range configFiles.Files {
+			if len(file.SecretContent) > 0 {
+				files = append(files, redhatcopv1alpha1.ConfigFile{Key: file.Key, SecretContent: file.SecretContent})
+			}
+		}
+
+		if len(files) > 0 {
+			out = append(out, redhatcopv1alpha1.ConfigFiles{SecretName: configFiles.SecretName, Files: files})
+		}
+	}
+
+	return out
+}
+
+// restoreInlineConfigFiles copies the inline content of the preserved v1alpha1 configuration files
+func restoreInlineConfigFiles(out []redhatcopv1alpha1.ConfigFiles, in []redhatcopv1alpha1.ConfigFiles) {
+	for i := range out {
+		for j := range out[i].Files {
+			for _, configFiles := range in {
+				for _, file := range configFiles.Files {
+					if configFiles.SecretName == out[i].SecretName && file.Key == out[i].Files[j].Key {
+						out[i].Files[j].SecretContent = file.SecretContent
+					}
+				}
+			}
+		}
+	}
+}
+
+// convertConfigFilesTo converts configuration files to v1alpha1, whose content is read from the referenced secret
+func convertConfigFilesTo(in []ConfigFiles) []redhatcopv1alpha1.ConfigFiles {
+	if in == nil {
+		return nil
+	}
+
+	out := []redhatcopv1alpha1.ConfigFiles{}
+
+	for _, configFiles := range in {
+		outConfigFiles := redhatcopv1alpha1.ConfigFiles{
+			SecretName: configFiles.SecretName,
+			Type:       redhatcopv1alpha1.ConfigFileType(configFiles.Type),
+		}
+
+		if configFiles.Files != nil {
+			outConfigFiles.Files = []redhatcopv1alpha1.ConfigFile{}
+		}
+
+		for _, file := range configFiles.Files {
+			outConfigFiles.Files = append(outConfigFiles.Files, redhatcopv1alpha1.ConfigFile{
+				Type:     redhatcopv1alpha1.ConfigFileType(file.Type),
+				Key:      file.Key,
+				Filename: file.Filename,
+			})
+		}
+
+		out = append(out, outConfigFiles)
+	}
+
+	return out
+}
+
+// convertConfigFilesFrom converts configuration files from v1alpha1 without their inline content
+// as v1alpha2 only references it through the secret
+func convertConfigFilesFrom(in []redhatcopv1alpha1.ConfigFiles) []ConfigFiles {
+	if in == nil {
+		return nil
+	}
+
+	out := []ConfigFiles{}
+
+	for _, configFiles := range in {
+		outConfigFiles := ConfigFiles{
+			SecretName: configFiles.SecretName,
+			Type:       ConfigFileType(configFiles.Type),
+		}
+
+		if configFiles.Files != nil {
+			outConfigFiles.Files = []ConfigFile{}
+		}
+
+		for _, file := range configFiles.Files {
+			outConfigFiles.Files = append(outConfigFiles.Files, ConfigFile{
+				Type:     ConfigFileType(file.Type),
+				Key:      file.Key,
+				Filename: file.Filename,
+			})
+		}
+
+		out = append(out, outConfigFiles)
+	}
+
+	return out
+}
+
+func convertStatusTo(in *QuayEcosystemStatus) redhatcopv1alpha1.QuayEcosystemStatus {
+	out := redhatcopv1alpha1.QuayEcosystemStatus{
+		Message:            in.Message,
+		Phase:              redhatcopv1alpha1.QuayEcosystemPhase(in.Phase),
+		Hostname:           in.Hostname,
+		SetupComplete:      in.SetupComplete,
+		ObservedGeneration: in.ObservedGeneration,
+		EffectiveSpec:      in.EffectiveSpec,
+		Replicas:           in.Replicas,
+		LabelSelector:      in.LabelSelector,
+	}
+
+	if in.Conditions != nil {
+		out.Conditions = []redhatcopv1alpha1.QuayEcosystemCondition{}
+	}
+
+	for _, condition := range in.Conditions {
+		out.Conditions = append(out.Conditions, redhatcopv1alpha1.QuayEcosystemCondition{
+			LastTransitionTime: condition.LastTransitionTime,
+			LastUpdateTime:     condition.LastUpdateTime,
+			Message:            condition.Message,
+			Reason:             condition.Reason,
+			Status:             condition.Status,
+			Type:               redhatcopv1alpha1.QuayEcosystemConditionType(condition.Type),
+		})
+	}
+
+	if in.Components != nil {
+		out.Components = &redhatcopv1alpha1.QuayEcosystemComponentsStatus{
+			Quay:          convertComponentStatusTo(in.Components.Quay),
+			QuayConfig:    convertComponentStatusTo(in.Components.QuayConfig),
+			RepoMirror:    convertComponentStatusTo(in.Components.RepoMirror),
+			Redis:         convertComponentStatusTo(in.Components.Redis),
+			QuayDatabase:  convertComponentStatusTo(in.Components.QuayDatabase),
+			Clair:         convertComponentStatusTo(in.Components.Clair),
+			ClairDatabase: convertComponentStatusTo(in.Components.ClairDatabase),
+		}
+	}
+
+	if in.APIToken != nil {
+		out.APIToken = &redhatcopv1alpha1.APITokenStatus{
+			SecretName:          in.APIToken.SecretName,
+			Organization:        in.APIToken.Organization,
+			ClientID:            in.APIToken.ClientID,
+			Scopes:              convertAPITokenScopesTo(in.APIToken.Scopes),
+			RegenerationRequest: in.APIToken.RegenerationRequest,
+			CreationTime:        in.APIToken.CreationTime,
+		}
+	}
+
+	if in.SmokeTest != nil {
+		smokeTest := redhatcopv1alpha1.RegistrySmokeTestStatus(*in.SmokeTest)
+		out.SmokeTest = &smokeTest
+	}
+
+	return out
+}
+
+func convertStatusFrom(in *redhatcopv1alpha1.QuayEcosystemStatus) QuayEcosystemStatus {
+	out := QuayEcosystemStatus{
+		Message:            in.Message,
+		Phase:              QuayEcosystemPhase(in.Phase),
+		Hostname:           in.Hostname,
+		SetupComplete:      in.SetupComplete,
+		ObservedGeneration: in.ObservedGeneration,
+		EffectiveSpec:      in.EffectiveSpec,
+		Replicas:           in.Replicas,
+		LabelSelector:      in.LabelSelector,
+	}
+
+	if in.Conditions != nil {
+		out.Conditions = []QuayEcosystemCondition{}
+	}
+
+	for _, condition := range in.Conditions {
+		out.Conditions = append(out.Conditions, QuayEcosystemCondition{
+			LastTransitionTime: condition.LastTransitionTime,
+			LastUpdateTime:     condition.LastUpdateTime,
+			Message:            condition.Message,
+			Reason:             condition.Reason,
+			Status:             condition.Status,
+			Type:               QuayEcosystemConditionType(condition.Type),
+		})
+	}
+
+	if in.Components != nil {
+		out.Components = &QuayEcosystemComponentsStatus{
+			Quay:          convertComponentStatusFrom(in.Components.Quay),
+			QuayConfig:    convertComponentStatusFrom(in.Components.QuayConfig),
+			RepoMirror:    convertComponentStatusFrom(in.Components.RepoMirror),
+			Redis:         convertComponentStatusFrom(in.Components.Redis),
+			QuayDatabase:  convertComponentStatusFrom(in.Components.QuayDatabase),
+			Clair:         convertComponentStatusFrom(in.Components.Clair),
+			ClairDatabase: convertComponentStatusFrom(in.Components.ClairDatabase),
+		}
+	}
+
+	if in.APIToken != nil {
+		out.APIToken = &APITokenStatus{
+			SecretName:          in.APIToken.SecretName,
+			Organization:        in.APIToken.Organization,
+			ClientID:            in.APIToken.ClientID,
+			Scopes:              convertAPITokenScopesFrom(in.APIToken.Scopes),
+			RegenerationRequest: in.APIToken.RegenerationRequest,
+			CreationTime:        in.APIToken.CreationTime,
+		}
+	}
+
+	if in.SmokeTest != nil {
+		smokeTest := RegistrySmokeTestStatus(*in.SmokeTest)
+		out.SmokeTest = &smokeTest
+	}
+
+	return out
+}
+
+func convertComponentStatusTo(in *ComponentStatus) *redhatcopv1alpha1.ComponentStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := redhatcopv1alpha1.ComponentStatus(*in)
+	return &out
+}
+
+func convertComponentStatusFrom(in *redhatcopv1alpha1.ComponentStatus) *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := ComponentStatus(*in)
+	return &out
+}
+
+func convertAPITokenTo(in *APIToken) *redhatcopv1alpha1.APIToken {
+	if in == nil {
+		return nil
+	}
+
+	return &redhatcopv1alpha1.APIToken{
+		Organization: in.Organization,
+		Scopes:       convertAPITokenScopesTo(in.Scopes),
+		SecretName:   in.SecretName,
+	}
+}
+
+func convertAPITokenFrom(in *redhatcopv1alpha1.APIToken) *APIToken {
+	if in == nil {
+		return nil
+	}
+
+	return &APIToken{
+		Organization: in.Organization,
+		Scopes:       convertAPITokenScopesFrom(in.Scopes),
+		SecretName:   in.SecretName,
+	}
+}
+
+func convertAPITokenScopesTo(in []QuayAPITokenScope) []redhatcopv1alpha1.QuayAPITokenScope {
+	if in == nil {
+		return nil
+	}
+
+	out := []redhatcopv1alpha1.QuayAPITokenScope{}
+	for _, scope := range in {
+		out = append(out, redhatcopv1alpha1.QuayAPITokenScope(scope))
+	}
+
+	return out
+}
+
+func convertAPITokenScopesFrom(in []redhatcopv1alpha1.QuayAPITokenScope) []QuayAPITokenScope {
+	if in == nil {
+		return nil
+	}
+
+	out := []QuayAPITokenScope{}
+	for _, scope := range in {
+		out = append(out, QuayAPITokenScope(scope))
+	}
+
+	return out
+}
+
+func isEmptyResources(resources corev1.ResourceRequirements) bool {
+	return len(resources.Limits) == 0 && len(resources.Requests) == 0
+}
@@ -0,0 +1,320 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newV1alpha1QuayEcosystem() *redhatcopv1alpha1.QuayEcosystem {
+
+	replicas := int32(2)
+	mirrorReplicas := int32(1)
+	keepConfigDeployment := true
+
+	return &redhatcopv1alpha1.QuayEcosystem{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "quay-ecosystem",
+			Namespace:   "quay-enterprise",
+			Annotations: map[string]string{"app": "quay"},
+		},
+		Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+			Quay: &redhatcopv1alpha1.Quay{
+				Image:                "quay.io/redhat/quay:v3.3.0",
+				Replicas:             &replicas,
+				EnvVars:              []corev1.EnvVar{{Name: "DEBUGLOG", Value: "true"}},
+				Resources:            corev1.ResourceRequirements{Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")}},
+				ConfigSecretName:     "quay-config-secret",
+				KeepConfigDeployment: &keepConfigDeployment,
+				EnableRepoMirroring:  true,
+				MirrorReplicas:       &mirrorReplicas,
+				SkipSetup:            false,
+				HorizontalPodAutoscaler: &redhatcopv1alpha1.HorizontalPodAutoscaler{
+					MaxReplicas: 4,
+				},
+				Superusers: []string{"quay", "admin"},
+				APIToken: &redhatcopv1alpha1.APIToken{
+					Scopes: []redhatcopv1alpha1.QuayAPITokenScope{redhatcopv1alpha1.RepoReadQuayAPITokenScope, redhatcopv1alpha1.OrgAdminQuayAPITokenScope},
+				},
+				SmokeTest: &redhatcopv1alpha1.RegistrySmokeTest{
+					Interval: "30m",
+				},
+				Database: &redhatcopv1alpha1.Database{
+					Server:                "postgresql.example.com",
+					CredentialsSecretName: "quay-database-credentials",
+				},
+				ExternalAccess: &redhatcopv1alpha1.ExternalAccess{
+					Type:     redhatcopv1alpha1.RouteExternalAccessType,
+					Hostname: "quay.example.com",
+					TLS: &redhatcopv1alpha1.TLSExternalAccess{
+						Termination:        redhatcopv1alpha1.PassthroughTLSTerminationType,
+						InsecureSkipVerify: true,
+					},
+				},
+				EnableStorageReplication: true,
+				RegistryBackends: []redhatcopv1alpha1.RegistryBackend{
+					{
+						Name:                  "s3",
+						CredentialsSecretName: "s3-credentials",
+						RegistryBackendSource: redhatcopv1alpha1.RegistryBackendSource{
+							S3: &redhatcopv1alpha1.S3RegistryBackendSource{
+								BucketName: "quay",
+								Host:       "s3.example.com",
+							},
+						},
+					},
+					{
+						Name:                  "azure",
+						CredentialsSecretName: "azure-credentials",
+						RegistryBackendSource: redhatcopv1alpha1.RegistryBackendSource{
+							Azure: &redhatcopv1alpha1.AzureRegistryBackendSource{
+								ContainerName: "quay",
+							},
+						},
+					},
+				},
+				ConfigFiles: []redhatcopv1alpha1.ConfigFiles{
+					{
+						SecretName: "quay-config-files",
+						Files: []redhatcopv1alpha1.ConfigFile{
+							{Key: "ca.crt", Filename: "ca.crt", Type: redhatcopv1alpha1.ExtraCaCertConfigFileType},
+							{Key: "config.yaml", Filename: "config.yaml", Type: redhatcopv1alpha1.ConfigConfigFileType},
+						},
+					},
+				},
+			},
+			Redis: &redhatcopv1alpha1.Redis{
+				Hostname: "redis.example.com",
+			},
+			Clair: &redhatcopv1alpha1.Clair{
+				Enabled:        true,
+				UpdateInterval: "1h",
+				Database: &redhatcopv1alpha1.Database{
+					VolumeSize: "10Gi",
+				},
+			},
+		},
+		Status: redhatcopv1alpha1.QuayEcosystemStatus{
+			Phase:              redhatcopv1alpha1.QuayEcosystemPhaseRunning,
+			Hostname:           "quay.example.com",
+			SetupComplete:      true,
+			ObservedGeneration: 3,
+			EffectiveSpec:      &runtime.RawExtension{Raw: []byte(`{"quay":{"image":"quay.io/redhat/quay:v3.3.0"}}`)},
+			Conditions: []redhatcopv1alpha1.QuayEcosystemCondition{
+				{
+					Type:   redhatcopv1alpha1.QuayEcosystemAvailableCondition,
+					Status: corev1.ConditionTrue,
+					Reason: string(redhatcopv1alpha1.QuayEcosystemQuayAvailable),
+				},
+			},
+			Components: &redhatcopv1alpha1.QuayEcosystemComponentsStatus{
+				Quay: &redhatcopv1alpha1.ComponentStatus{
+					DesiredReplicas: 2,
+					ReadyReplicas:   2,
+					Endpoint:        "quay-ecosystem-quay.quay-enterprise.svc:8443",
+				},
+			},
+			APIToken: &redhatcopv1alpha1.APITokenStatus{
+				SecretName:   "quay-ecosystem-quay-api-token",
+				Organization: "quay-operator",
+				ClientID:     "QWERTY",
+				Scopes:       []redhatcopv1alpha1.QuayAPITokenScope{redhatcopv1alpha1.RepoReadQuayAPITokenScope, redhatcopv1alpha1.OrgAdminQuayAPITokenScope},
+			},
+			SmokeTest: &redhatcopv1alpha1.RegistrySmokeTestStatus{
+				ObservedGeneration: 3,
+			},
+		},
+	}
+}
+
+func TestConvertFrom(t *testing.T) {
+
+	hub := newV1alpha1QuayEcosystem()
+
+	quayEcosystem := &QuayEcosystem{}
+	err := quayEcosystem.ConvertFrom(hub)
+
+	assert.NoError(t, err)
+	assert.Equal(t, hub.Spec.Quay.Replicas, quayEcosystem.Spec.Quay.App.Replicas)
+	assert.Equal(t, hub.Spec.Quay.ConfigSecretName, quayEcosystem.Spec.Quay.Config.SecretName)
+	assert.Equal(t, hub.Spec.Quay.MirrorReplicas, quayEcosystem.Spec.Quay.RepoMirror.Replicas)
+	assert.True(t, quayEcosystem.Spec.Quay.RepoMirror.Enabled)
+	assert.Equal(t, int32(4), quayEcosystem.Spec.Quay.App.HorizontalPodAutoscaler.MaxReplicas)
+	assert.Equal(t, hub.Spec.Quay.Superusers, quayEcosystem.Spec.Quay.Setup.Superusers)
+	assert.Equal(t, []QuayAPITokenScope{"repo:read", "org:admin"}, quayEcosystem.Spec.Quay.Setup.APIToken.Scopes)
+	assert.Equal(t, "QWERTY", quayEcosystem.Status.APIToken.ClientID)
+	assert.Equal(t, "30m", quayEcosystem.Spec.Quay.SmokeTest.Interval)
+	assert.Equal(t, int64(3), quayEcosystem.Status.SmokeTest.ObservedGeneration)
+	assert.Equal(t, "s3.example.com", quayEcosystem.Spec.Quay.Storage.Backends[0].S3.Host)
+	assert.Equal(t, "azure-credentials", quayEcosystem.Spec.Quay.Storage.Backends[1].CredentialsSecretName)
+	assert.Equal(t, map[string]string{"app": "quay"}, quayEcosystem.Annotations)
+
+	// The source object must not be modified
+	assert.Equal(t, newV1alpha1QuayEcosystem(), hub)
+}
+
+func TestConvertFromPreservesInlineContent(t *testing.T) {
+
+	cases := []struct {
+		name   string
+		modify func(hub *redhatcopv1alpha1.QuayEcosystem)
+	}{
+		{
+			name: "registryBackend",
+			modify: func(hub *redhatcopv1alpha1.QuayEcosystem) {
+				hub.Spec.Quay.RegistryBackends[0].S3.SecretKey = "secret"
+				hub.Spec.Quay.RegistryBackends[1].Azure.AccountKey = "key"
+			},
+		},
+		{
+			name: "quayConfigFile",
+			modify: func(hub *redhatcopv1alpha1.QuayEcosystem) {
+				hub.Spec.Quay.ConfigFiles[0].Files[0].SecretContent = []byte("certificate")
+			},
+		},
+		{
+			name: "clairConfigFile",
+			modify: func(hub *redhatcopv1alpha1.QuayEcosystem) {
+				hub.Annotations = nil
+				hub.Spec.Clair.ConfigFiles = []redhatcopv1alpha1.ConfigFiles{{SecretName: "clair-config-files", Files: []redhatcopv1alpha1.ConfigFile{{Key: "ca.crt", SecretContent: []byte("certificate")}}}}
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			expected := newV1alpha1QuayEcosystem()
+			c.modify(expected)
+
+			quayEcosystem := &QuayEcosystem{}
+			assert.NoError(t, quayEcosystem.ConvertFrom(expected))
+			assert.Contains(t, quayEcosystem.Annotations, InlineContentAnnotation)
+
+			hub := &redhatcopv1alpha1.QuayEcosystem{}
+			assert.NoError(t, quayEcosystem.ConvertTo(hub))
+
+			assert.Equal(t, expected, hub)
+		})
+	}
+
+	// The content is restored into the matching backend after it is changed in v1alpha2
+	expected := newV1alpha1QuayEcosystem()
+	expected.Spec.Quay.RegistryBackends[0].S3.SecretKey = "secret"
+
+	quayEcosystem := &QuayEcosystem{}
+	assert.NoError(t, quayEcosystem.ConvertFrom(expected))
+
+	quayEcosystem.Spec.Quay.Storage.Backends = quayEcosystem.Spec.Quay.Storage.Backends[:1]
+	quayEcosystem.Spec.Quay.Storage.Backends[0].S3.BucketName = "registry"
+
+	hub := &redhatcopv1alpha1.QuayEcosystem{}
+	assert.NoError(t, quayEcosystem.ConvertTo(hub))
+	assert.Equal(t, "secret", hub.Spec.Quay.RegistryBackends[0].S3.SecretKey)
+	assert.Equal(t, "registry", hub.Spec.Quay.RegistryBackends[0].S3.BucketName)
+
+	quayEcosystem.Annotations[InlineContentAnnotation] = "credentials"
+	assert.Error(t, quayEcosystem.ConvertTo(&redhatcopv1alpha1.QuayEcosystem{}))
+}
+
+func TestRoundTripFromHub(t *testing.T) {
+
+	cases := []struct {
+		name string
+		hub  *redhatcopv1alpha1.QuayEcosystem
+	}{
+		{
+			name: "full",
+			hub:  newV1alpha1QuayEcosystem(),
+		},
+		{
+			name: "empty",
+			hub:  &redhatcopv1alpha1.QuayEcosystem{ObjectMeta: metav1.ObjectMeta{Name: "quay-ecosystem"}},
+		},
+		{
+			name: "quayOnly",
+			hub: &redhatcopv1alpha1.QuayEcosystem{
+				ObjectMeta: metav1.ObjectMeta{Name: "quay-ecosystem"},
+				Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+					Quay: &redhatcopv1alpha1.Quay{Image: "quay.io/redhat/quay:v3.3.0"},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			quayEcosystem := &QuayEcosystem{}
+			assert.NoError(t, quayEcosystem.ConvertFrom(c.hub))
+
+			hub := &redhatcopv1alpha1.QuayEcosystem{}
+			assert.NoError(t, quayEcosystem.ConvertTo(hub))
+
+			assert.Equal(t, c.hub, hub)
+		})
+	}
+}
+
+func TestRoundTripToHub(t *testing.T) {
+
+	replicas := int32(3)
+
+	quayEcosystem := &QuayEcosystem{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quay-ecosystem",
+			Namespace: "quay-enterprise",
+		},
+		Spec: QuayEcosystemSpec{
+			Quay: &Quay{
+				Image: "quay.io/redhat/quay:v3.3.0",
+				App: &QuayApp{
+					Replicas: &replicas,
+				},
+				Config: &QuayConfig{
+					SecretName: "quay-config-secret",
+				},
+				Setup: &QuaySetup{
+					SuperuserCredentialsSecretName: "quay-superuser",
+				},
+				Storage: &QuayStorage{
+					Backends: []RegistryBackend{
+						{
+							Name:                  "s3",
+							CredentialsSecretName: "s3-credentials",
+							RegistryBackendSource: RegistryBackendSource{
+								S3: &S3RegistryBackendSource{
+									BucketName: "quay",
+								},
+							},
+						},
+					},
+					PersistentVolume: &RegistryStorage{
+						PersistentVolumeSize: "50Gi",
+					},
+				},
+			},
+			Clair: &Clair{
+				Enabled: true,
+			},
+		},
+	}
+
+	hub := &redhatcopv1alpha1.QuayEcosystem{}
+	assert.NoError(t, quayEcosystem.ConvertTo(hub))
+
+	assert.Equal(t, &replicas, hub.Spec.Quay.Replicas)
+	assert.Equal(t, "quay-config-secret", hub.Spec.Quay.ConfigSecretName)
+	assert.Equal(t, "s3-credentials", hub.Spec.Quay.RegistryBackends[0].CredentialsSecretName)
+	assert.Equal(t, "50Gi", hub.Spec.Quay.RegistryStorage.PersistentVolumeSize)
+
+	converted := &QuayEcosystem{}
+	assert.NoError(t, converted.ConvertFrom(hub))
+
+	assert.Equal(t, quayEcosystem, converted)
+}
@@ -0,0 +1,592 @@
+package v1alpha2
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// QuayEcosystemSpec defines the desired state of QuayEcosystem
+// +k8s:openapi-gen=true
+type QuayEcosystemSpec struct {
+	Quay  *Quay  `json:"quay,omitempty"`
+	Redis *Redis `json:"redis,omitempty"`
+	Clair *Clair `json:"clair,omitempty"`
+}
+
+// QuayEcosystemPhase defines the phase of lifecycle the operator is running in
+type QuayEcosystemPhase string
+
+// QuayEcosystemConditionType defines the types of conditions the operator will run through
+type QuayEcosystemConditionType string
+
+// ConfigFileType defines the type of configuration file
+type ConfigFileType string
+
+// ExternalAccessType defines the method for accessing Quay from an external source
+type ExternalAccessType string
+
+// TLSTerminationType defines the the method for TLS termination
+type TLSTerminationType string
+
+// QuayMigrationPhase defines the phase of a Quay database migration
+type QuayMigrationPhase string
+
+// QuayAPITokenScope defines a permission granted to an OAuth token for the Quay API
+// +kubebuilder:validation:Enum="repo:read";"repo:write";"repo:admin";"repo:create";"user:read";"user:admin";"org:admin";"super:user"
+type QuayAPITokenScope string
+
+const (
+
+	// QuayEcosystemAvailableCondition indicates that Quay is deployed and serving requests
+	QuayEcosystemAvailableCondition QuayEcosystemConditionType = "Available"
+
+	// QuayEcosystemProgressingCondition indicates that the operator is actively rolling out changes
+	QuayEcosystemProgressingCondition QuayEcosystemConditionType = "Progressing"
+
+	// QuayEcosystemDegradedCondition indicates that the last reconciliation failed
+	QuayEcosystemDegradedCondition QuayEcosystemConditionType = "Degraded"
+
+	// QuayEcosystemSetupCompleteCondition indicates that the initial Quay setup process has completed
+	QuayEcosystemSetupCompleteCondition QuayEcosystemConditionType = "SetupComplete"
+
+	// QuayEcosystemRegistryFunctionalCondition indicates that the last registry smoke test pushed, pulled and deleted an image successfully
+	QuayEcosystemRegistryFunctionalCondition QuayEcosystemConditionType = "RegistryFunctional"
+
+	// ExtraCaCertConfigFileType specifies a Extra Ca Certificate file type
+	ExtraCaCertConfigFileType ConfigFileType = "extraCaCert"
+
+	// ConfigConfigFileType specifies a Config file type
+	ConfigConfigFileType ConfigFileType = "config"
+
+	// RouteExternalAccessType specifies external access using a Route
+	RouteExternalAccessType ExternalAccessType = "Route"
+
+	// LoadBalancerExternalAccessType specifies external access using a LoadBalancer
+	LoadBalancerExternalAccessType ExternalAccessType = "LoadBalancer"
+
+	// IngressExternalAccessType specifies external access using a Ingress
+	IngressExternalAccessType ExternalAccessType = "Ingress"
+
+	// NodePortExternalAccessType specifies external access using a NodePort
+	NodePortExternalAccessType ExternalAccessType = "NodePort"
+
+	// EdgeTLSTerminationType specifies that TLS termination will occur at the Router
+	EdgeTLSTerminationType TLSTerminationType = "edge"
+
+	// ReencryptTLSTerminationType specifies that TLS rencryption will occur
+	ReencryptTLSTerminationType TLSTerminationType = "reencrypt"
+
+	// PassthroughTLSTerminationType specifies that TLS termination will occur at the application
+	PassthroughTLSTerminationType TLSTerminationType = "passthrough"
+
+	// NoneTLSTerminationType specifies that SSL will be disabled
+	NoneTLSTerminationType TLSTerminationType = "none"
+
+	// NewInstallation specifies a new installation of Quay
+	NewInstallation QuayMigrationPhase = "new-installation"
+
+	// AddNewFields specifies the add-new-fields migration phase
+	AddNewFields QuayMigrationPhase = "add-new-fields"
+
+	// BackfillThenReadOnlyNew specifies the backfill-then-read-only-new migration phase
+	BackfillThenReadOnlyNew QuayMigrationPhase = "backfill-then-read-only-new"
+
+	// RemoveOldField specifies the remove-old-field migration phase
+	RemoveOldField QuayMigrationPhase = "remove-old-field"
+)
+
+// QuayEcosystemStatus defines the observed state of QuayEcosystem
+// +k8s:openapi-gen=true
+type QuayEcosystemStatus struct {
+	Message  string             `json:"message,omitempty"`
+	Phase    QuayEcosystemPhase `json:"phase,omitempty"`
+	Hostname string             `json:"hostname,omitempty"`
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=atomic
+	Conditions    []QuayEcosystemCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,2,rep,name=conditions"`
+	SetupComplete bool                     `json:"setupComplete,omitempty"`
+	// ObservedGeneration is the most recent generation of the specification processed by the operator
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// EffectiveSpec is the v1alpha1 QuayEcosystemSpec after defaults have been applied by the operator
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	EffectiveSpec *runtime.RawExtension `json:"effectiveSpec,omitempty"`
+	// Components reports the state of each component managed by the operator
+	// +optional
+	Components *QuayEcosystemComponentsStatus `json:"components,omitempty"`
+	// Replicas is the number of ready Quay application pods and is reported by the scale subresource
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// LabelSelector selects the Quay application pods and is used by the scale subresource
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// APIToken reports the OAuth token for the Quay API minted by the operator
+	// +optional
+	APIToken *APITokenStatus `json:"apiToken,omitempty"`
+	// SmokeTest reports the last registry smoke test run by the operator
+	// +optional
+	SmokeTest *RegistrySmokeTestStatus `json:"smokeTest,omitempty"`
+}
+
+// RegistrySmokeTestStatus defines the observed state of the registry smoke test
+// +k8s:openapi-gen=true
+type RegistrySmokeTestStatus struct {
+	// LastRunTime is the time the smoke test last ran
+	// +optional
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+	// ObservedGeneration is the generation of the QuayEcosystem the smoke test last ran for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// APITokenStatus defines the observed state of the OAuth token for the Quay API minted by the operator
+// +k8s:openapi-gen=true
+type APITokenStatus struct {
+	// SecretName is the name of the Secret containing the token
+	SecretName string `json:"secretName"`
+	// Organization is the organization containing the OAuth application the token was issued for
+	Organization string `json:"organization"`
+	// ClientID is the client ID of the OAuth application the token was issued for
+	ClientID string `json:"clientID"`
+	// Scopes are the permissions granted to the token
+	// +listType=set
+	Scopes []QuayAPITokenScope `json:"scopes,omitempty"`
+	// RegenerationRequest is the value of the token regeneration annotation last processed by the operator
+	// +optional
+	RegenerationRequest string `json:"regenerationRequest,omitempty"`
+	// CreationTime is the time the token was minted
+	// +optional
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+}
+
+// QuayEcosystemComponentsStatus defines the observed state of each component of the QuayEcosystem
+// +k8s:openapi-gen=true
+type QuayEcosystemComponentsStatus struct {
+	Quay          *ComponentStatus `json:"quay,omitempty"`
+	QuayConfig    *ComponentStatus `json:"quayConfig,omitempty"`
+	RepoMirror    *ComponentStatus `json:"repoMirror,omitempty"`
+	Redis         *ComponentStatus `json:"redis,omitempty"`
+	QuayDatabase  *ComponentStatus `json:"quayDatabase,omitempty"`
+	Clair         *ComponentStatus `json:"clair,omitempty"`
+	ClairDatabase *ComponentStatus `json:"clairDatabase,omitempty"`
+}
+
+// ComponentStatus defines the observed state of a component deployed by the operator
+// +k8s:openapi-gen=true
+type ComponentStatus struct {
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	ReadyReplicas   int32  `json:"readyReplicas"`
+	Image           string `json:"image,omitempty"`
+	Endpoint        string `json:"endpoint,omitempty"`
+	LastError       string `json:"lastError,omitempty"`
+}
+
+// QuayEcosystemCondition defines a list of conditions that the object will transiton through
+// +k8s:openapi-gen=true
+type QuayEcosystemCondition struct {
+	LastTransitionTime metav1.Time                `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+	LastUpdateTime     metav1.Time                `json:"lastUpdateTime,omitempty" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+	Message            string                     `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	Reason             string                     `json:"reason,omitempty" protobuf:"bytes,5,opt,name=reason"`
+	Status             corev1.ConditionStatus     `json:"status" protobuf:"bytes,2,opt,name=status,casttype=k8s.io/kubernetes/pkg/api/v1.ConditionStatus"`
+	Type               QuayEcosystemConditionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=QuayEcosystemConditionType"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayEcosystem is the Schema for the quayecosystems API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quayecosystems,scope=Namespaced
+// +kubebuilder:subresource:scale:specpath=.spec.quay.app.replicas,statuspath=.status.replicas,selectorpath=.status.labelSelector
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Lifecycle phase of the QuayEcosystem"
+// +kubebuilder:printcolumn:name="Hostname",type="string",JSONPath=".status.hostname",description="Hostname of Quay"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type==\"Available\")].status",description="Whether Quay is available"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type QuayEcosystem struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuayEcosystemSpec   `json:"spec,omitempty"`
+	Status QuayEcosystemStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuayEcosystemList contains a list of QuayEcosystem
+type QuayEcosystemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuayEcosystem `json:"items"`
+}
+
+// Quay defines the properies of a deployment of Quay
+// Properties at this level apply to the Quay application, config and repository mirror deployments
+// +k8s:openapi-gen=true
+type Quay struct {
+	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+	DeploymentStrategy  appsv1.DeploymentStrategyType `json:"deploymentStrategy,omitempty"`
+	Image               string                        `json:"image,omitempty"`
+	ImagePullSecretName string                        `json:"imagePullSecretName,omitempty"`
+	NodeSelector        map[string]string             `json:"nodeSelector,omitempty" protobuf:"bytes,7,rep,name=nodeSelector"`
+	SecurityContext     *corev1.PodSecurityContext    `json:"securityContext,omitempty" protobuf:"bytes,14,opt,name=securityContext"`
+
+	App        *QuayApp        `json:"app,omitempty"`
+	Config     *QuayConfig     `json:"config,omitempty"`
+	RepoMirror *QuayRepoMirror `json:"repoMirror,omitempty"`
+
+	Database       *Database       `json:"database,omitempty"`
+	ExternalAccess *ExternalAccess `json:"externalAccess,omitempty"`
+	Setup          *QuaySetup      `json:"setup,omitempty"`
+	Storage        *QuayStorage    `json:"storage,omitempty"`
+
+	// SmokeTest requests the registry to be verified periodically by pushing, pulling and deleting an image once setup has completed
+	// +optional
+	SmokeTest *RegistrySmokeTest `json:"smokeTest,omitempty"`
+
+	// +optional
+	// +patchMergeKey=secretName
+	// +patchStrategy=merge
+	// +listType=atomic
+	ConfigFiles []ConfigFiles `json:"configFiles,omitempty" patchStrategy:"merge" patchMergeKey:"secretName" protobuf:"bytes,2,rep,name=configFiles"`
+	// +kubebuilder:validation:Enum=new-installation;add-new-fields;backfill-then-read-only-new;remove-old-field
+	MigrationPhase QuayMigrationPhase `json:"migrationPhase,omitempty" protobuf:"bytes,1,opt,name=migrationPhase,casttype=QuayMigrationPhase"`
+}
+
+// QuayApp defines the properties of the Quay application deployment
+// +k8s:openapi-gen=true
+type QuayApp struct {
+	// +listType=atomic
+	EnvVars        []corev1.EnvVar             `json:"envVars,omitempty"`
+	LivenessProbe  *corev1.Probe               `json:"livenessProbe,omitempty"`
+	ReadinessProbe *corev1.Probe               `json:"readinessProbe,omitempty"`
+	Replicas       *int32                      `json:"replicas,omitempty"`
+	Resources      corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+	// +listType=set
+	Tolerations             []corev1.Toleration      `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	HorizontalPodAutoscaler *HorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+}
+
+// QuayConfig defines the properties of the Quay config application deployment
+// +k8s:openapi-gen=true
+type QuayConfig struct {
+	// +listType=atomic
+	EnvVars        []corev1.EnvVar             `json:"envVars,omitempty"`
+	KeepDeployment *bool                       `json:"keepDeployment,omitempty"`
+	Resources      corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+	SecretName     string                      `json:"secretName,omitempty"`
+	// +listType=set
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+}
+
+// QuayRepoMirror defines the properties of the Quay repository mirror deployment
+// +k8s:openapi-gen=true
+type QuayRepoMirror struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// +listType=atomic
+	EnvVars        []corev1.EnvVar             `json:"envVars,omitempty"`
+	Replicas       *int32                      `json:"replicas,omitempty"`
+	Resources      corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+	ServerHostname string                      `json:"serverHostname,omitempty"`
+	TLSVerify      bool                        `json:"tlsVerify,omitempty"`
+	// +listType=set
+	Tolerations             []corev1.Toleration      `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	HorizontalPodAutoscaler *HorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+}
+
+// QuaySetup defines the properties of the initial Quay setup process
+// +k8s:openapi-gen=true
+type QuaySetup struct {
+	Skip                           bool   `json:"skip,omitempty"`
+	SuperuserCredentialsSecretName string `json:"superuserCredentialsSecretName,omitempty"`
+	// +listType=set
+	Superusers []string `json:"superusers,omitempty"`
+	// APIToken requests an OAuth token for the Quay API to be minted by the operator once setup has completed
+	// +optional
+	APIToken *APIToken `json:"apiToken,omitempty"`
+}
+
+// APIToken defines the OAuth token for the Quay API minted by the operator on behalf of the initial superuser
+// +k8s:openapi-gen=true
+type APIToken struct {
+	// Organization is the organization administered by the initial superuser that contains the OAuth application of the operator. Defaults to quay-operator
+	// +optional
+	Organization string `json:"organization,omitempty"`
+	// Scopes are the permissions granted to the token. Defaults to all scopes
+	// +optional
+	// +listType=set
+	Scopes []QuayAPITokenScope `json:"scopes,omitempty"`
+	// SecretName is the name of the Secret the token is stored in. Defaults to <name>-quay-api-token
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// RegistrySmokeTest defines the smoke test verifying the registry on behalf of the initial superuser
+// +k8s:openapi-gen=true
+type RegistrySmokeTest struct {
+	// Organization is the organization dedicated to the smoke test which is created when it does not exist. Defaults to quay-operator-smoke-test
+	// +optional
+	Organization string `json:"organization,omitempty"`
+	// Interval is the duration between runs of the smoke test, for example 30m. Defaults to 1h
+	// +optional
+	Interval string `json:"interval,omitempty"`
+}
+
+// QuayStorage defines the storage of registry content
+// +k8s:openapi-gen=true
+type QuayStorage struct {
+	// +listType=atomic
+	Backends          []RegistryBackend `json:"backends,omitempty"`
+	EnableReplication bool              `json:"enableReplication,omitempty"`
+	PersistentVolume  *RegistryStorage  `json:"persistentVolume,omitempty"`
+}
+
+// Redis defines the properies of a deployment of Redis
+// +k8s:openapi-gen=true
+type Redis struct {
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+	DeploymentStrategy appsv1.DeploymentStrategyType `json:"deploymentStrategy,omitempty"`
+	// +listType=atomic
+	EnvVars             []corev1.EnvVar             `json:"envVars,omitempty"`
+	Hostname            string                      `json:"hostname,omitempty"`
+	Image               string                      `json:"image,omitempty"`
+	ImagePullSecretName string                      `json:"imagePullSecretName,omitempty"`
+	LivenessProbe       *corev1.Probe               `json:"livenessProbe,omitempty"`
+	NodeSelector        map[string]string           `json:"nodeSelector,omitempty" protobuf:"bytes,7,rep,name=nodeSelector"`
+	Port                *int32                      `json:"port,omitempty"`
+	ReadinessProbe      *corev1.Probe               `json:"readinessProbe,omitempty"`
+	Replicas            *int32                      `json:"replicas,omitempty"`
+	Resources           corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+	SecurityContext     *corev1.PodSecurityContext  `json:"securityContext,omitempty" protobuf:"bytes,14,opt,name=securityContext"`
+	// +listType=set
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+}
+
+// Database defines a database that will be deployed to support a particular component
+// +k8s:openapi-gen=true
+type Database struct {
+	CPU                   string `json:"cpu,omitempty"`
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+
+	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+	DeploymentStrategy appsv1.DeploymentStrategyType `json:"deploymentStrategy,omitempty"`
+	// +listType=atomic
+	EnvVars              []corev1.EnvVar             `json:"envVars,omitempty"`
+	Image                string                      `json:"image,omitempty"`
+	ImagePullSecretName  string                      `json:"imagePullSecretName,omitempty"`
+	LivenessProbe        *corev1.Probe               `json:"livenessProbe,omitempty"`
+	Memory               string                      `json:"memory,omitempty"`
+	NodeSelector         map[string]string           `json:"nodeSelector,omitempty" protobuf:"bytes,7,rep,name=nodeSelector"`
+	ReadinessProbe       *corev1.Probe               `json:"readinessProbe,omitempty"`
+	Replicas             *int32                      `json:"replicas,omitempty"`
+	Resources            corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+	Server               string                      `json:"server,omitempty"`
+	VolumeSize           string                      `json:"volumeSize,omitempty"`
+	ConnectionParameters map[string]string           `json:"connectionParameters,omitempty" protobuf:"bytes,7,rep,name=connectionParameters"`
+	StorageClass         string                      `json:"storageClass,omitempty"`
+	SecurityContext      *corev1.PodSecurityContext  `json:"securityContext,omitempty" protobuf:"bytes,14,opt,name=securityContext"`
+	// +listType=set
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+}
+
+// Clair defines the properties of a deployment of Clair
+// +k8s:openapi-gen=true
+type Clair struct {
+	Database *Database `json:"database,omitempty"`
+
+	// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+	DeploymentStrategy appsv1.DeploymentStrategyType `json:"deploymentStrategy,omitempty"`
+
+	Enabled bool `json:"enabled,omitempty"`
+	// +listType=atomic
+	EnvVars                   []corev1.EnvVar             `json:"envVars,omitempty"`
+	Image                     string                      `json:"image,omitempty"`
+	ImagePullSecretName       string                      `json:"imagePullSecretName,omitempty"`
+	LivenessProbe             *corev1.Probe               `json:"livenessProbe,omitempty"`
+	NodeSelector              map[string]string           `json:"nodeSelector,omitempty" protobuf:"bytes,7,rep,name=nodeSelector"`
+	ReadinessProbe            *corev1.Probe               `json:"readinessProbe,omitempty"`
+	Replicas                  *int32                      `json:"replicas,omitempty"`
+	Resources                 corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,2,opt,name=resources"`
+	SslCertificatesSecretName string                      `json:"sslCertificatesSecretName,omitempty"`
+	UpdateInterval            string                      `json:"updateInterval,omitempty"`
+	SecurityContext           *corev1.PodSecurityContext  `json:"securityContext,omitempty" protobuf:"bytes,14,opt,name=securityContext"`
+	// +optional
+	// +patchMergeKey=secretName
+	// +patchStrategy=merge
+	// +listType=atomic
+	ConfigFiles []ConfigFiles `json:"configFiles,omitempty" patchStrategy:"merge" patchMergeKey:"secretName" protobuf:"bytes,2,rep,name=configFiles"`
+	// +listType=set
+	Tolerations             []corev1.Toleration      `json:"tolerations,omitempty" protobuf:"bytes,22,opt,name=tolerations"`
+	HorizontalPodAutoscaler *HorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+}
+
+// HorizontalPodAutoscaler defines a HorizontalPodAutoscaler that scales a component based on CPU utilization
+// When specified, the operator no longer manages the number of replicas of the component
+// +k8s:openapi-gen=true
+type HorizontalPodAutoscaler struct {
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+}
+
+// RegistryBackend defines a particular backend supporting the Quay registry
+// Credentials for the backend are provided by the Secret referenced by CredentialsSecretName
+// +k8s:openapi-gen=true
+type RegistryBackend struct {
+	Name                  string `json:"name"`
+	RegistryBackendSource `json:",inline" protobuf:"bytes,2,opt,name=registryBackendSource"`
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+	ReplicateByDefault    *bool  `json:"replicateByDefault,omitempty"`
+}
+
+// RegistryBackendSource defines the specific configurations to support the Quay registry
+// +k8s:openapi-gen=true
+type RegistryBackendSource struct {
+	Local        *LocalRegistryBackendSource        `json:"local,omitempty,name=local"`
+	S3           *S3RegistryBackendSource           `json:"s3,omitempty,name=s3"`
+	GoogleCloud  *GoogleCloudRegistryBackendSource  `json:"googleCloud,omitempty,name=googleCloud"`
+	Azure        *AzureRegistryBackendSource        `json:"azure,omitempty,name=azure"`
+	RADOS        *RADOSRegistryBackendSource        `json:"rados,omitempty,name=rados"`
+	RHOCS        *RHOCSRegistryBackendSource        `json:"rhocs,omitempty,name=rhocs"`
+	Swift        *SwiftRegistryBackendSource        `json:"swift,omitempty,name=swift"`
+	CloudfrontS3 *CloudfrontS3RegistryBackendSource `json:"cloudfrontS3,omitempty,name=cloudfrontS3"`
+}
+
+// RegistryStorage defines the configurations to support persistent storage
+// +k8s:openapi-gen=true
+type RegistryStorage struct {
+	// +listType=set
+	PersistentVolumeAccessModes      []corev1.PersistentVolumeAccessMode `json:"persistentVolumeAccessModes,omitempty,name=persistentVolumeAccessModes"`
+	PersistentVolumeSize             string                              `json:"persistentVolumeSize,omitempty,name=volumeSize"`
+	PersistentVolumeStorageClassName string                              `json:"persistentVolumeStorageClassName,omitempty,name=storageClassName"`
+}
+
+// ExternalAccess defines the properies of a Quay External Access
+// +k8s:openapi-gen=true
+type ExternalAccess struct {
+	Annotations       map[string]string  `json:"annotations,omitempty" protobuf:"bytes,7,rep,name=annotations"`
+	ConfigAnnotations map[string]string  `json:"configAnnotations,omitempty" protobuf:"bytes,7,rep,name=configAnnotations"`
+	ConfigHostname    string             `json:"configHostname,omitempty"`
+	ConfigNodePort    *int32             `json:"configNodePort,omitempty"`
+	Hostname          string             `json:"hostname,omitempty"`
+	NodePort          *int32             `json:"nodePort,omitempty"`
+	TLS               *TLSExternalAccess `json:"tls,omitempty"`
+	// +kubebuilder:validation:Enum=Route;LoadBalancer;NodePort;Ingress
+	Type ExternalAccessType `json:"type,omitempty"`
+}
+
+// TLSExternalAccess defines the properies of TLS properties for External Access
+// +k8s:openapi-gen=true
+type TLSExternalAccess struct {
+	SecretName string `json:"secretName,omitempty"`
+	// termination indicates termination type.
+	Termination TLSTerminationType `json:"termination" protobuf:"bytes,1,opt,name=termination,casttype=TLSTerminationType"`
+	// InsecureSkipVerify disables the verification of the certificates presented by Quay and its config app to the operator
+	// Certificates are otherwise verified against the system roots, the certificates of Quay and Clair and the extraCaCert config files
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// LocalRegistryBackendSource defines local registry storage
+// +k8s:openapi-gen=true
+type LocalRegistryBackendSource struct {
+	StoragePath string `json:"storagePath,omitempty,name=storagePath"`
+}
+
+// S3RegistryBackendSource defines S3 registry storage
+// +k8s:openapi-gen=true
+type S3RegistryBackendSource struct {
+	StoragePath string `json:"storagePath,omitempty,name=storagePath"`
+	BucketName  string `json:"bucketName,omitempty,name=bucketName"`
+	Host        string `json:"host,omitempty,name=host"`
+	Port        int    `json:"port,omitempty,name=port"`
+}
+
+// GoogleCloudRegistryBackendSource defines Google Cloud registry storage
+// +k8s:openapi-gen=true
+type GoogleCloudRegistryBackendSource struct {
+	StoragePath string `json:"storagePath,omitempty,name=storagePath"`
+	BucketName  string `json:"bucketName,omitempty,name=bucketName"`
+}
+
+// AzureRegistryBackendSource defines Azure blob registry storage
+// +k8s:openapi-gen=true
+type AzureRegistryBackendSource struct {
+	StoragePath   string `json:"storagePath,omitempty,name=storagePath"`
+	ContainerName string `json:"containerName,omitempty,name=containerName"`
+}
+
+// RADOSRegistryBackendSource defines Ceph RADOS registry storage
+// +k8s:openapi-gen=true
+type RADOSRegistryBackendSource struct {
+	StoragePath string `json:"storagePath,omitempty,name=storagePath"`
+	BucketName  string `json:"bucketName,omitempty,name=bucketName"`
+	Hostname    string `json:"hostname,omitempty,name=hostname"`
+	Secure      bool   `json:"secure,omitempty,name=secure"`
+	Port        int    `json:"port,omitempty,name=port"`
+}
+
+// RHOCSRegistryBackendSource defines RHOCS registry storage
+// +k8s:openapi-gen=true
+type RHOCSRegistryBackendSource struct {
+	StoragePath string `json:"storagePath,omitempty,name=storagePath"`
+	BucketName  string `json:"bucketName,omitempty,name=bucketName"`
+	Hostname    string `json:"hostname,omitempty,name=hostname"`
+	Secure      bool   `json:"secure,omitempty,name=secure"`
+	Port        int    `json:"port,omitempty,name=port"`
+}
+
+// SwiftRegistryBackendSource defines Swift registry storage
+// +k8s:openapi-gen=true
+type SwiftRegistryBackendSource struct {
+	StoragePath string            `json:"storagePath,omitempty,name=storagePath"`
+	AuthVersion string            `json:"authVersion,omitempty,name=authVersion"`
+	AuthURL     string            `json:"authURL,omitempty,name=authURL"`
+	Container   string            `json:"container,omitempty,name=container"`
+	CACertPath  string            `json:"caCertPath,omitempty,name=caCertPath"`
+	TempURLKey  string            `json:"tempURLKey,omitempty,name=tempURLKey"`
+	OSOptions   map[string]string `json:"osOptions,omitempty" protobuf:"bytes,7,rep,name=osOptions"`
+}
+
+// CloudfrontS3RegistryBackendSource defines CouldfrontS3 registry storage
+// +k8s:openapi-gen=true
+type CloudfrontS3RegistryBackendSource struct {
+	StoragePath        string `json:"storagePath,omitempty,name=storagePath"`
+	BucketName         string `json:"bucketName,omitempty,name=bucketName"`
+	Host               string `json:"host,omitempty,name=host"`
+	Port               int    `json:"port,omitempty,name=port"`
+	DistributionDomain string `json:"distributionDomain,omitempty,name=distributionDomain"`
+	KeyID              string `json:"keyID,omitempty,name=keyID"`
+	PrivateKeyFilename string `json:"privateKeyFilename,omitempty,name=privateKeyFilename"`
+}
+
+// ConfigFiles defines configuration files that are injected into the Quay resources
+// +k8s:openapi-gen=true
+type ConfigFiles struct {
+	SecretName string `json:"secretName"`
+	// +listType=atomic
+	Files []ConfigFile   `json:"files,omitempty,name=files"`
+	Type  ConfigFileType `json:"type,omitempty,name=type"`
+}
+
+// ConfigFile defines configuration files that are injected into the Quay resources
+// +k8s:openapi-gen=true
+type ConfigFile struct {
+	// +kubebuilder:validation:Enum=config;extraCaCert
+	Type     ConfigFileType `json:"type,omitempty,name=type"`
+	Key      string         `json:"key,name=key"`
+	Filename string         `json:"filename,omitempty,name=filename"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuayEcosystem{}, &QuayEcosystemList{})
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBodyLength is the maximum length of an unstructured response body reported in an APIError
+const maxErrorBodyLength = 512
+
+// APIError is returned when Quay responds to a request with an unsuccessful status
+type APIError struct {
+	// StatusCode is the HTTP status of the response
+	StatusCode int `json:"-"`
+	// Method and Path identify the failed request
+	Method string `json:"-"`
+	Path   string `json:"-"`
+	// ErrorType, Detail and Message are reported by Quay in the body of the response when available
+	ErrorType string `json:"error_type"`
+	Detail    string `json:"detail"`
+	Message   string `json:"error_message"`
+}
+
+func (e *APIError) Error() string {
+
+	message := fmt.Sprintf("Quay API request %s %s failed with status %d", e.Method, e.Path, e.StatusCode)
+
+	if e.ErrorType != "" {
+		message = fmt.Sprintf("%s (%s)", message, e.ErrorType)
+	}
+
+	switch {
+	case e.Detail != "":
+		message = fmt.Sprintf("%s: %s", message, e.Detail)
+	case e.Message != "":
+		message = fmt.Sprintf("%s: %s", message, e.Message)
+	}
+
+	return message
+}
+
+// newAPIError builds the error describing the unsuccessful response from the details reported by Quay in its body
+func newAPIError(resp *http.Response) *APIError {
+
+	apiError := &APIError{StatusCode: resp.StatusCode}
+
+	if resp.Request != nil {
+		apiError.Method = resp.Request.Method
+		apiError.Path = resp.Request.URL.Path
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return apiError
+	}
+
+	// Errors not raised by the Quay API itself such as those of a router are not in JSON
+	if json.Unmarshal(body, apiError) != nil {
+		message := strings.TrimSpace(string(body))
+		if len(message) > maxErrorBodyLength {
+			message = message[:maxErrorBodyLength]
+		}
+		apiError.Message = message
+	}
+
+	return apiError
+}
+
+// IsAPIError determines whether the error was returned for an unsuccessful response from Quay
+func IsAPIError(err error) bool {
+	_, ok := err.(*APIError)
+	return ok
+}
+
+// IsStatus determines whether Quay responded with the provided status
+func IsStatus(err error, statusCode int) bool {
+	apiError, ok := err.(*APIError)
+	return ok && apiError.StatusCode == statusCode
+}
+
+// IsNotFound determines whether Quay responded that the requested object does not exist
+func IsNotFound(err error) bool {
+	return IsStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized determines whether Quay rejected the credentials of the client
+func IsUnauthorized(err error) bool {
+	return IsStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden determines whether the client is not allowed to perform the request
+func IsForbidden(err error) bool {
+	return IsStatus(err, http.StatusForbidden)
+}
+
+// IsConflict determines whether the request conflicts with an existing object
+func IsConflict(err error) bool {
+	return IsStatus(err, http.StatusConflict)
+}
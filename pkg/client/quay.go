@@ -2,13 +2,18 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type QuayClient struct {
@@ -16,6 +21,16 @@ type QuayClient struct {
 	httpClient *http.Client
 	Username   string
 	Password   string
+	// Token is an OAuth access token authenticating requests in place of the username and password
+	Token string
+	// RequestTimeout bounds each attempt of a request whose context has no deadline
+	RequestTimeout time.Duration
+	// Retry controls how requests failing with a transient error are retried
+	Retry RetryPolicy
+	// session indicates that requests are authenticated by the cookie of a signed in session
+	session bool
+	// csrfToken is the CSRF token of the signed in session
+	csrfToken string
 }
 
 type QuayValidationType string
@@ -29,8 +44,8 @@ const (
 	SslValidation         QuayValidationType = "ssl"
 )
 
-func (c *QuayClient) InitializationConfiguration() (*http.Response, StringValue, error) {
-	req, err := c.newRequest("POST", "/api/v1/configapp/initialization", StringValue{})
+func (c *QuayClient) InitializationConfiguration(ctx context.Context) (*http.Response, StringValue, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/configapp/initialization", StringValue{})
 	if err != nil {
 		return nil, StringValue{}, err
 	}
@@ -40,8 +55,8 @@ func (c *QuayClient) InitializationConfiguration() (*http.Response, StringValue,
 	return resp, initializationResponse, err
 }
 
-func (c *QuayClient) GetQuayConfiguration() (*http.Response, QuayConfig, error) {
-	req, err := c.newRequest("GET", "/api/v1/superuser/config", nil)
+func (c *QuayClient) GetQuayConfiguration(ctx context.Context) (*http.Response, QuayConfig, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/superuser/config", nil)
 	if err != nil {
 		return nil, QuayConfig{}, err
 	}
@@ -51,8 +66,8 @@ func (c *QuayClient) GetQuayConfiguration() (*http.Response, QuayConfig, error)
 	return resp, quayConfig, err
 }
 
-func (c *QuayClient) UpdateQuayConfiguration(config QuayConfig) (*http.Response, QuayConfig, error) {
-	req, err := c.newRequest("PUT", "/api/v1/superuser/config", config)
+func (c *QuayClient) UpdateQuayConfiguration(ctx context.Context, config QuayConfig) (*http.Response, QuayConfig, error) {
+	req, err := c.newRequest(ctx, "PUT", "/api/v1/superuser/config", config)
 	if err != nil {
 		return nil, QuayConfig{}, err
 	}
@@ -62,8 +77,8 @@ func (c *QuayClient) UpdateQuayConfiguration(config QuayConfig) (*http.Response,
 	return resp, quayConfig, err
 }
 
-func (c *QuayClient) GetRegistryStatus() (*http.Response, RegistryStatus, error) {
-	req, err := c.newRequest("GET", "/api/v1/superuser/registrystatus", nil)
+func (c *QuayClient) GetRegistryStatus(ctx context.Context) (*http.Response, RegistryStatus, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/superuser/registrystatus", nil)
 	if err != nil {
 		return nil, RegistryStatus{}, err
 	}
@@ -73,8 +88,8 @@ func (c *QuayClient) GetRegistryStatus() (*http.Response, RegistryStatus, error)
 	return resp, registryStatus, err
 }
 
-func (c *QuayClient) GetKeys() (*http.Response, KeysResponse, error) {
-	req, err := c.newRequest("GET", "/api/v1/superuser/keys", nil)
+func (c *QuayClient) GetKeys(ctx context.Context) (*http.Response, KeysResponse, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/superuser/keys", nil)
 	if err != nil {
 		return nil, KeysResponse{}, err
 	}
@@ -84,8 +99,8 @@ func (c *QuayClient) GetKeys() (*http.Response, KeysResponse, error) {
 	return resp, keysResponse, err
 }
 
-func (c *QuayClient) GetKey(kid string) (*http.Response, Key, error) {
-	req, err := c.newRequest("GET", fmt.Sprintf("/api/v1/superuser/keys/%s", kid), nil)
+func (c *QuayClient) GetKey(ctx context.Context, kid string) (*http.Response, Key, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/superuser/keys/%s", kid), nil)
 	if err != nil {
 		return nil, Key{}, err
 	}
@@ -95,8 +110,8 @@ func (c *QuayClient) GetKey(kid string) (*http.Response, Key, error) {
 	return resp, key, err
 }
 
-func (c *QuayClient) CreateKey(key KeyCreationRequest) (*http.Response, KeyCreationResponse, error) {
-	req, err := c.newRequest("POST", "/api/v1/superuser/keys", key)
+func (c *QuayClient) CreateKey(ctx context.Context, key KeyCreationRequest) (*http.Response, KeyCreationResponse, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/superuser/keys", key)
 	if err != nil {
 		return nil, KeyCreationResponse{}, err
 	}
@@ -106,8 +121,8 @@ func (c *QuayClient) CreateKey(key KeyCreationRequest) (*http.Response, KeyCreat
 	return resp, keyCreationResponse, err
 }
 
-func (c *QuayClient) ValidateDatabase(config QuayConfig) (*http.Response, QuayStatusResponse, error) {
-	req, err := c.newRequest("POST", "/api/v1/superuser/config/validate/database", config)
+func (c *QuayClient) ValidateDatabase(ctx context.Context, config QuayConfig) (*http.Response, QuayStatusResponse, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/superuser/config/validate/database", config)
 	if err != nil {
 		return nil, QuayStatusResponse{}, err
 	}
@@ -117,8 +132,8 @@ func (c *QuayClient) ValidateDatabase(config QuayConfig) (*http.Response, QuaySt
 	return resp, quayStatusResponse, err
 }
 
-func (c *QuayClient) ValidateComponent(config QuayConfig, validationType QuayValidationType) (*http.Response, QuayStatusResponse, error) {
-	req, err := c.newRequest("POST", fmt.Sprintf("/api/v1/superuser/config/validate/%s", validationType), config)
+func (c *QuayClient) ValidateComponent(ctx context.Context, config QuayConfig, validationType QuayValidationType) (*http.Response, QuayStatusResponse, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/superuser/config/validate/%s", validationType), config)
 	if err != nil {
 		return nil, QuayStatusResponse{}, err
 	}
@@ -128,8 +143,8 @@ func (c *QuayClient) ValidateComponent(config QuayConfig, validationType QuayVal
 	return resp, quayStatusResponse, err
 }
 
-func (c *QuayClient) ValidateRedis(config QuayConfig) (*http.Response, QuayStatusResponse, error) {
-	req, err := c.newRequest("POST", "/api/v1/superuser/config/validate/redis", config)
+func (c *QuayClient) ValidateRedis(ctx context.Context, config QuayConfig) (*http.Response, QuayStatusResponse, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/superuser/config/validate/redis", config)
 	if err != nil {
 		return nil, QuayStatusResponse{}, err
 	}
@@ -139,8 +154,8 @@ func (c *QuayClient) ValidateRedis(config QuayConfig) (*http.Response, QuayStatu
 	return resp, quayStatusResponse, err
 }
 
-func (c *QuayClient) SetupDatabase() (*http.Response, SetupDatabaseResponse, error) {
-	req, err := c.newRequest("GET", "/api/v1/superuser/setupdb", nil)
+func (c *QuayClient) SetupDatabase(ctx context.Context) (*http.Response, SetupDatabaseResponse, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/superuser/setupdb", nil)
 	if err != nil {
 		return nil, SetupDatabaseResponse{}, err
 	}
@@ -150,8 +165,8 @@ func (c *QuayClient) SetupDatabase() (*http.Response, SetupDatabaseResponse, err
 	return resp, setupDatabaseResponse, err
 }
 
-func (c *QuayClient) CreateSuperuser(config QuayCreateSuperuserRequest) (*http.Response, QuayStatusResponse, error) {
-	req, err := c.newRequest("POST", "/api/v1/superuser/config/createsuperuser", config)
+func (c *QuayClient) CreateSuperuser(ctx context.Context, config QuayCreateSuperuserRequest) (*http.Response, QuayStatusResponse, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/superuser/config/createsuperuser", config)
 	if err != nil {
 		return nil, QuayStatusResponse{}, err
 	}
@@ -161,8 +176,8 @@ func (c *QuayClient) CreateSuperuser(config QuayCreateSuperuserRequest) (*http.R
 	return resp, quayStatusResponse, err
 }
 
-func (c *QuayClient) CompleteSetup() (*http.Response, StringValue, error) {
-	req, err := c.newRequest("POST", "/api/v1/kubernetes/config", StringValue{})
+func (c *QuayClient) CompleteSetup(ctx context.Context) (*http.Response, StringValue, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/kubernetes/config", StringValue{})
 	if err != nil {
 		return nil, StringValue{}, err
 	}
@@ -172,8 +187,8 @@ func (c *QuayClient) CompleteSetup() (*http.Response, StringValue, error) {
 	return resp, setupResponse, err
 }
 
-func (c *QuayClient) GetConfigFileStatus(fileName string) (*http.Response, ConfigFileStatus, error) {
-	req, err := c.newRequest("GET", fmt.Sprintf("/api/v1/superuser/config/file/%s", fileName), nil)
+func (c *QuayClient) GetConfigFileStatus(ctx context.Context, fileName string) (*http.Response, ConfigFileStatus, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/superuser/config/file/%s", fileName), nil)
 	if err != nil {
 		return nil, ConfigFileStatus{}, err
 	}
@@ -183,9 +198,9 @@ func (c *QuayClient) GetConfigFileStatus(fileName string) (*http.Response, Confi
 	return resp, configFileStatus, err
 }
 
-func (c *QuayClient) UploadFileResource(fileName string, content []byte) (*http.Response, QuayStatusResponse, error) {
+func (c *QuayClient) UploadFileResource(ctx context.Context, fileName string, content []byte) (*http.Response, QuayStatusResponse, error) {
 
-	req, err := c.newFileUploadRequest("POST", fmt.Sprintf("/api/v1/superuser/config/file/%s", fileName), fileName, content)
+	req, err := c.newFileUploadRequest(ctx, "POST", fmt.Sprintf("/api/v1/superuser/config/file/%s", fileName), fileName, content)
 	if err != nil {
 		return nil, QuayStatusResponse{}, err
 	}
@@ -195,7 +210,815 @@ func (c *QuayClient) UploadFileResource(fileName string, content []byte) (*http.
 	return resp, quayStatusResponse, err
 }
 
-func (c *QuayClient) newFileUploadRequest(method, path string, fileName string, content []byte) (*http.Request, error) {
+func (c *QuayClient) GetOrganization(ctx context.Context, orgName string) (*http.Response, Organization, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s", orgName), nil)
+	if err != nil {
+		return nil, Organization{}, err
+	}
+	var organization Organization
+	resp, err := c.do(req, &organization)
+
+	return resp, organization, err
+}
+
+func (c *QuayClient) CreateOrganization(ctx context.Context, organization OrganizationCreateRequest) (*http.Response, StringValue, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/organization/", organization)
+	if err != nil {
+		return nil, StringValue{}, err
+	}
+	var createResponse StringValue
+	resp, err := c.do(req, &createResponse)
+
+	return resp, createResponse, err
+}
+
+func (c *QuayClient) UpdateOrganization(ctx context.Context, orgName string, organization OrganizationUpdateRequest) (*http.Response, Organization, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/organization/%s", orgName), organization)
+	if err != nil {
+		return nil, Organization{}, err
+	}
+	var updatedOrganization Organization
+	resp, err := c.do(req, &updatedOrganization)
+
+	return resp, updatedOrganization, err
+}
+
+func (c *QuayClient) DeleteOrganization(ctx context.Context, orgName string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organization/%s", orgName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetOrganizationMembers(ctx context.Context, orgName string) (*http.Response, OrganizationMembers, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/members", orgName), nil)
+	if err != nil {
+		return nil, OrganizationMembers{}, err
+	}
+	var members OrganizationMembers
+	resp, err := c.do(req, &members)
+
+	return resp, members, err
+}
+
+func (c *QuayClient) GetOrganizationLogs(ctx context.Context, orgName string, nextPage string) (*http.Response, Logs, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/logs", orgName), nil)
+	if err != nil {
+		return nil, Logs{}, err
+	}
+	req.URL.RawQuery = nextPageQuery(nextPage).Encode()
+	var logs Logs
+	resp, err := c.do(req, &logs)
+
+	return resp, logs, err
+}
+
+// GetAllOrganizationLogs follows the pages of the logs of the organization and returns the entries of every page
+func (c *QuayClient) GetAllOrganizationLogs(ctx context.Context, orgName string) (*http.Response, []LogEntry, error) {
+	entries := []LogEntry{}
+	nextPage := ""
+	for {
+		resp, logs, err := c.GetOrganizationLogs(ctx, orgName, nextPage)
+		if err != nil {
+			return resp, nil, err
+		}
+		entries = append(entries, logs.Logs...)
+		if logs.NextPage == "" {
+			return resp, entries, nil
+		}
+		nextPage = logs.NextPage
+	}
+}
+
+func (c *QuayClient) GetOrganizationPrototypes(ctx context.Context, orgName string) (*http.Response, Prototypes, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/prototypes", orgName), nil)
+	if err != nil {
+		return nil, Prototypes{}, err
+	}
+	var prototypes Prototypes
+	resp, err := c.do(req, &prototypes)
+
+	return resp, prototypes, err
+}
+
+func (c *QuayClient) CreateOrganizationPrototype(ctx context.Context, orgName string, prototype PrototypeCreateRequest) (*http.Response, Prototype, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/organization/%s/prototypes", orgName), prototype)
+	if err != nil {
+		return nil, Prototype{}, err
+	}
+	var createdPrototype Prototype
+	resp, err := c.do(req, &createdPrototype)
+
+	return resp, createdPrototype, err
+}
+
+func (c *QuayClient) DeleteOrganizationPrototype(ctx context.Context, orgName string, prototypeID string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organization/%s/prototypes/%s", orgName, prototypeID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetOrganizationApplications(ctx context.Context, orgName string) (*http.Response, Applications, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/applications", orgName), nil)
+	if err != nil {
+		return nil, Applications{}, err
+	}
+	var applications Applications
+	resp, err := c.do(req, &applications)
+
+	return resp, applications, err
+}
+
+func (c *QuayClient) CreateOrganizationApplication(ctx context.Context, orgName string, application ApplicationCreateRequest) (*http.Response, Application, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/organization/%s/applications", orgName), application)
+	if err != nil {
+		return nil, Application{}, err
+	}
+	var createdApplication Application
+	resp, err := c.do(req, &createdApplication)
+
+	return resp, createdApplication, err
+}
+
+func (c *QuayClient) DeleteOrganizationApplication(ctx context.Context, orgName string, clientID string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organization/%s/applications/%s", orgName, clientID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+// SignIn signs in as the user of the client and returns a client whose requests are made within the signed in session
+// Quay only accepts basic authentication for a few endpoints of its API whereas a session has the permissions of the user
+func (c *QuayClient) SignIn(ctx context.Context) (*http.Response, *QuayClient, error) {
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Redirects are not followed so that the access tokens issued within the session can be read from their location
+	session := *c
+	session.session = true
+	session.httpClient = &http.Client{
+		Transport: c.httpClient.Transport,
+		Timeout:   c.httpClient.Timeout,
+		Jar:       jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, csrfToken, err := session.getCSRFToken(ctx)
+	if err != nil {
+		return resp, nil, err
+	}
+	session.csrfToken = csrfToken.CSRFToken
+
+	req, err := session.newRequest(ctx, "POST", "/api/v1/signin", SigninRequest{Username: c.Username, Password: c.Password})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err = session.do(req, nil)
+	if err != nil {
+		return resp, nil, err
+	}
+
+	// Signing in starts a new session with its own token
+	resp, csrfToken, err = session.getCSRFToken(ctx)
+	if err != nil {
+		return resp, nil, err
+	}
+	session.csrfToken = csrfToken.CSRFToken
+
+	return resp, &session, nil
+}
+
+// CreateApplicationAccessToken authorizes the OAuth application to obtain an access token with the provided scopes for the user of the client
+// Quay only issues tokens to signed in users so the client signs in unless it already belongs to a session
+// An error is returned when any of the requests is unsuccessful since the response of the authorization is a redirect
+func (c *QuayClient) CreateApplicationAccessToken(ctx context.Context, clientID string, redirectURI string, scopes []string) (*http.Response, string, error) {
+
+	session := c
+
+	if !c.session {
+		resp, signedIn, err := c.SignIn(ctx)
+		if err != nil {
+			return resp, "", err
+		}
+		session = signedIn
+	}
+
+	form := url.Values{}
+	form.Set("client_id", clientID)
+	form.Set("redirect_uri", redirectURI)
+	form.Set("scope", strings.Join(scopes, " "))
+	form.Set("response_type", "token")
+	form.Set("_csrf_token", session.csrfToken)
+
+	u := c.BaseURL.ResolveReference(&url.URL{Path: "/oauth/authorizeapp"})
+
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// The redirect is the expected response so the request is not sent through do which rejects it
+	authorizeCtx, cancel := c.requestContext(ctx)
+	defer cancel()
+
+	resp, err := session.httpClient.Do(req.WithContext(authorizeCtx))
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusFound {
+		return resp, "", newAPIError(resp)
+	}
+
+	location, err := resp.Location()
+	if err != nil {
+		return resp, "", err
+	}
+
+	fragment, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		return resp, "", err
+	}
+
+	accessToken := fragment.Get("access_token")
+	if accessToken == "" {
+		return resp, "", fmt.Errorf("No access token was issued for application %s: %s", clientID, fragment.Get("error"))
+	}
+
+	return resp, accessToken, nil
+}
+
+func (c *QuayClient) getCSRFToken(ctx context.Context) (*http.Response, CSRFToken, error) {
+	req, err := c.newRequest(ctx, "GET", "/csrf_token", nil)
+	if err != nil {
+		return nil, CSRFToken{}, err
+	}
+	var csrfToken CSRFToken
+	resp, err := c.do(req, &csrfToken)
+
+	return resp, csrfToken, err
+}
+
+func (c *QuayClient) GetUser(ctx context.Context) (*http.Response, User, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/user/", nil)
+	if err != nil {
+		return nil, User{}, err
+	}
+	var user User
+	resp, err := c.do(req, &user)
+
+	return resp, user, err
+}
+
+func (c *QuayClient) GetSuperuserUsers(ctx context.Context) (*http.Response, Users, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/superuser/users/", nil)
+	if err != nil {
+		return nil, Users{}, err
+	}
+	var users Users
+	resp, err := c.do(req, &users)
+
+	return resp, users, err
+}
+
+func (c *QuayClient) GetSuperuserUser(ctx context.Context, username string) (*http.Response, User, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/superuser/users/%s", username), nil)
+	if err != nil {
+		return nil, User{}, err
+	}
+	var user User
+	resp, err := c.do(req, &user)
+
+	return resp, user, err
+}
+
+func (c *QuayClient) CreateSuperuserUser(ctx context.Context, user UserCreateRequest) (*http.Response, User, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/superuser/users/", user)
+	if err != nil {
+		return nil, User{}, err
+	}
+	var createdUser User
+	resp, err := c.do(req, &createdUser)
+
+	return resp, createdUser, err
+}
+
+func (c *QuayClient) UpdateSuperuserUser(ctx context.Context, username string, user UserUpdateRequest) (*http.Response, User, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/superuser/users/%s", username), user)
+	if err != nil {
+		return nil, User{}, err
+	}
+	var updatedUser User
+	resp, err := c.do(req, &updatedUser)
+
+	return resp, updatedUser, err
+}
+
+func (c *QuayClient) DeleteSuperuserUser(ctx context.Context, username string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/superuser/users/%s", username), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetSuperuserOrganizations(ctx context.Context) (*http.Response, Organizations, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/superuser/organizations/", nil)
+	if err != nil {
+		return nil, Organizations{}, err
+	}
+	var organizations Organizations
+	resp, err := c.do(req, &organizations)
+
+	return resp, organizations, err
+}
+
+func (c *QuayClient) DeleteSuperuserOrganization(ctx context.Context, orgName string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/superuser/organizations/%s", orgName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetRepository(ctx context.Context, namespace string, name string) (*http.Response, Repository, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s", namespace, name), nil)
+	if err != nil {
+		return nil, Repository{}, err
+	}
+	var repository Repository
+	resp, err := c.do(req, &repository)
+
+	return resp, repository, err
+}
+
+func (c *QuayClient) CreateRepository(ctx context.Context, repository RepositoryCreateRequest) (*http.Response, Repository, error) {
+	req, err := c.newRequest(ctx, "POST", "/api/v1/repository", repository)
+	if err != nil {
+		return nil, Repository{}, err
+	}
+	var createdRepository Repository
+	resp, err := c.do(req, &createdRepository)
+
+	return resp, createdRepository, err
+}
+
+func (c *QuayClient) UpdateRepository(ctx context.Context, namespace string, name string, repository RepositoryUpdateRequest) (*http.Response, StringValue, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/repository/%s/%s", namespace, name), repository)
+	if err != nil {
+		return nil, StringValue{}, err
+	}
+	var updateResponse StringValue
+	resp, err := c.do(req, &updateResponse)
+
+	return resp, updateResponse, err
+}
+
+func (c *QuayClient) ChangeRepositoryVisibility(ctx context.Context, namespace string, name string, visibility RepositoryVisibilityRequest) (*http.Response, StringValue, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/repository/%s/%s/changevisibility", namespace, name), visibility)
+	if err != nil {
+		return nil, StringValue{}, err
+	}
+	var visibilityResponse StringValue
+	resp, err := c.do(req, &visibilityResponse)
+
+	return resp, visibilityResponse, err
+}
+
+func (c *QuayClient) DeleteRepository(ctx context.Context, namespace string, name string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/repository/%s/%s", namespace, name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) ChangeRepositoryState(ctx context.Context, namespace string, name string, state RepositoryStateRequest) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/repository/%s/%s/changestate", namespace, name), state)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetRepositoryMirror(ctx context.Context, namespace string, name string) (*http.Response, RepositoryMirror, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s/mirror", namespace, name), nil)
+	if err != nil {
+		return nil, RepositoryMirror{}, err
+	}
+	var mirror RepositoryMirror
+	resp, err := c.do(req, &mirror)
+
+	return resp, mirror, err
+}
+
+func (c *QuayClient) CreateRepositoryMirror(ctx context.Context, namespace string, name string, mirror RepositoryMirrorRequest) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/repository/%s/%s/mirror", namespace, name), mirror)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) UpdateRepositoryMirror(ctx context.Context, namespace string, name string, mirror RepositoryMirrorRequest) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/repository/%s/%s/mirror", namespace, name), mirror)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetRepositories(ctx context.Context, namespace string, nextPage string) (*http.Response, Repositories, error) {
+	req, err := c.newRequest(ctx, "GET", "/api/v1/repository", nil)
+	if err != nil {
+		return nil, Repositories{}, err
+	}
+	query := nextPageQuery(nextPage)
+	query.Set("namespace", namespace)
+	req.URL.RawQuery = query.Encode()
+	var repositories Repositories
+	resp, err := c.do(req, &repositories)
+
+	return resp, repositories, err
+}
+
+// GetAllRepositories follows the pages of the repositories of the namespace and returns the repositories of every page
+func (c *QuayClient) GetAllRepositories(ctx context.Context, namespace string) (*http.Response, []Repository, error) {
+	repositories := []Repository{}
+	nextPage := ""
+	for {
+		resp, page, err := c.GetRepositories(ctx, namespace, nextPage)
+		if err != nil {
+			return resp, nil, err
+		}
+		repositories = append(repositories, page.Repositories...)
+		if page.NextPage == "" {
+			return resp, repositories, nil
+		}
+		nextPage = page.NextPage
+	}
+}
+
+func (c *QuayClient) GetRepositoryTags(ctx context.Context, namespace string, name string, page int) (*http.Response, Tags, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s/tag/", namespace, name), nil)
+	if err != nil {
+		return nil, Tags{}, err
+	}
+	req.URL.RawQuery = url.Values{"onlyActiveTags": []string{"true"}, "page": []string{strconv.Itoa(page)}, "limit": []string{"100"}}.Encode()
+	var tags Tags
+	resp, err := c.do(req, &tags)
+
+	return resp, tags, err
+}
+
+// GetAllRepositoryTags follows the pages of the active tags of the repository and returns the tags of every page
+func (c *QuayClient) GetAllRepositoryTags(ctx context.Context, namespace string, name string) (*http.Response, []Tag, error) {
+	tags := []Tag{}
+	for page := 1; ; page++ {
+		resp, pageTags, err := c.GetRepositoryTags(ctx, namespace, name, page)
+		if err != nil {
+			return resp, nil, err
+		}
+		tags = append(tags, pageTags.Tags...)
+		if !pageTags.HasAdditional {
+			return resp, tags, nil
+		}
+	}
+}
+
+func (c *QuayClient) ChangeRepositoryTag(ctx context.Context, namespace string, name string, tag string, update TagUpdateRequest) (*http.Response, StringValue, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/repository/%s/%s/tag/%s", namespace, name, tag), update)
+	if err != nil {
+		return nil, StringValue{}, err
+	}
+	var updateResponse StringValue
+	resp, err := c.do(req, &updateResponse)
+
+	return resp, updateResponse, err
+}
+
+func (c *QuayClient) DeleteRepositoryTag(ctx context.Context, namespace string, name string, tag string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/repository/%s/%s/tag/%s", namespace, name, tag), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetRepositoryLogs(ctx context.Context, namespace string, name string, nextPage string) (*http.Response, Logs, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s/logs", namespace, name), nil)
+	if err != nil {
+		return nil, Logs{}, err
+	}
+	req.URL.RawQuery = nextPageQuery(nextPage).Encode()
+	var logs Logs
+	resp, err := c.do(req, &logs)
+
+	return resp, logs, err
+}
+
+// GetAllRepositoryLogs follows the pages of the logs of the repository and returns the entries of every page
+func (c *QuayClient) GetAllRepositoryLogs(ctx context.Context, namespace string, name string) (*http.Response, []LogEntry, error) {
+	entries := []LogEntry{}
+	nextPage := ""
+	for {
+		resp, logs, err := c.GetRepositoryLogs(ctx, namespace, name, nextPage)
+		if err != nil {
+			return resp, nil, err
+		}
+		entries = append(entries, logs.Logs...)
+		if logs.NextPage == "" {
+			return resp, entries, nil
+		}
+		nextPage = logs.NextPage
+	}
+}
+
+func (c *QuayClient) GetRepositoryNotifications(ctx context.Context, namespace string, name string) (*http.Response, RepositoryNotifications, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s/notification/", namespace, name), nil)
+	if err != nil {
+		return nil, RepositoryNotifications{}, err
+	}
+	var notifications RepositoryNotifications
+	resp, err := c.do(req, &notifications)
+
+	return resp, notifications, err
+}
+
+func (c *QuayClient) GetRepositoryNotification(ctx context.Context, namespace string, name string, uuid string) (*http.Response, RepositoryNotification, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s/notification/%s", namespace, name, uuid), nil)
+	if err != nil {
+		return nil, RepositoryNotification{}, err
+	}
+	var notification RepositoryNotification
+	resp, err := c.do(req, &notification)
+
+	return resp, notification, err
+}
+
+func (c *QuayClient) CreateRepositoryNotification(ctx context.Context, namespace string, name string, notification RepositoryNotificationCreateRequest) (*http.Response, RepositoryNotification, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/repository/%s/%s/notification/", namespace, name), notification)
+	if err != nil {
+		return nil, RepositoryNotification{}, err
+	}
+	var createdNotification RepositoryNotification
+	resp, err := c.do(req, &createdNotification)
+
+	return resp, createdNotification, err
+}
+
+func (c *QuayClient) DeleteRepositoryNotification(ctx context.Context, namespace string, name string, uuid string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/repository/%s/%s/notification/%s", namespace, name, uuid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) TestRepositoryNotification(ctx context.Context, namespace string, name string, uuid string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/repository/%s/%s/notification/%s/test", namespace, name, uuid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetRepositoryUserPermissions(ctx context.Context, namespace string, name string) (*http.Response, RepositoryPermissions, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s/permissions/user/", namespace, name), nil)
+	if err != nil {
+		return nil, RepositoryPermissions{}, err
+	}
+	var permissions RepositoryPermissions
+	resp, err := c.do(req, &permissions)
+
+	return resp, permissions, err
+}
+
+func (c *QuayClient) SetRepositoryUserPermission(ctx context.Context, namespace string, name string, username string, permission RepositoryPermissionRequest) (*http.Response, RepositoryPermission, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/repository/%s/%s/permissions/user/%s", namespace, name, username), permission)
+	if err != nil {
+		return nil, RepositoryPermission{}, err
+	}
+	var updatedPermission RepositoryPermission
+	resp, err := c.do(req, &updatedPermission)
+
+	return resp, updatedPermission, err
+}
+
+func (c *QuayClient) DeleteRepositoryUserPermission(ctx context.Context, namespace string, name string, username string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/repository/%s/%s/permissions/user/%s", namespace, name, username), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetRepositoryTeamPermissions(ctx context.Context, namespace string, name string) (*http.Response, RepositoryPermissions, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/repository/%s/%s/permissions/team/", namespace, name), nil)
+	if err != nil {
+		return nil, RepositoryPermissions{}, err
+	}
+	var permissions RepositoryPermissions
+	resp, err := c.do(req, &permissions)
+
+	return resp, permissions, err
+}
+
+func (c *QuayClient) SetRepositoryTeamPermission(ctx context.Context, namespace string, name string, teamName string, permission RepositoryPermissionRequest) (*http.Response, RepositoryPermission, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/repository/%s/%s/permissions/team/%s", namespace, name, teamName), permission)
+	if err != nil {
+		return nil, RepositoryPermission{}, err
+	}
+	var updatedPermission RepositoryPermission
+	resp, err := c.do(req, &updatedPermission)
+
+	return resp, updatedPermission, err
+}
+
+func (c *QuayClient) DeleteRepositoryTeamPermission(ctx context.Context, namespace string, name string, teamName string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/repository/%s/%s/permissions/team/%s", namespace, name, teamName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetOrganizationRobots(ctx context.Context, orgName string) (*http.Response, RobotAccounts, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/robots", orgName), nil)
+	if err != nil {
+		return nil, RobotAccounts{}, err
+	}
+	var robots RobotAccounts
+	resp, err := c.do(req, &robots)
+
+	return resp, robots, err
+}
+
+func (c *QuayClient) GetOrganizationRobot(ctx context.Context, orgName string, robotShortname string) (*http.Response, RobotAccount, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/robots/%s", orgName, robotShortname), nil)
+	if err != nil {
+		return nil, RobotAccount{}, err
+	}
+	var robotAccount RobotAccount
+	resp, err := c.do(req, &robotAccount)
+
+	return resp, robotAccount, err
+}
+
+func (c *QuayClient) CreateOrganizationRobot(ctx context.Context, orgName string, robotShortname string, robotAccount RobotAccountCreateRequest) (*http.Response, RobotAccount, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/organization/%s/robots/%s", orgName, robotShortname), robotAccount)
+	if err != nil {
+		return nil, RobotAccount{}, err
+	}
+	var createdRobotAccount RobotAccount
+	resp, err := c.do(req, &createdRobotAccount)
+
+	return resp, createdRobotAccount, err
+}
+
+func (c *QuayClient) DeleteOrganizationRobot(ctx context.Context, orgName string, robotShortname string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organization/%s/robots/%s", orgName, robotShortname), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) RegenerateOrganizationRobotToken(ctx context.Context, orgName string, robotShortname string) (*http.Response, RobotAccount, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/organization/%s/robots/%s/regenerate", orgName, robotShortname), nil)
+	if err != nil {
+		return nil, RobotAccount{}, err
+	}
+	var robotAccount RobotAccount
+	resp, err := c.do(req, &robotAccount)
+
+	return resp, robotAccount, err
+}
+
+func (c *QuayClient) GetOrganizationRobotPermissions(ctx context.Context, orgName string, robotShortname string) (*http.Response, RobotAccountPermissions, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/robots/%s/permissions", orgName, robotShortname), nil)
+	if err != nil {
+		return nil, RobotAccountPermissions{}, err
+	}
+	var permissions RobotAccountPermissions
+	resp, err := c.do(req, &permissions)
+
+	return resp, permissions, err
+}
+
+func (c *QuayClient) UpdateOrganizationTeam(ctx context.Context, orgName string, teamName string, team TeamUpdateRequest) (*http.Response, Team, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/organization/%s/team/%s", orgName, teamName), team)
+	if err != nil {
+		return nil, Team{}, err
+	}
+	var updatedTeam Team
+	resp, err := c.do(req, &updatedTeam)
+
+	return resp, updatedTeam, err
+}
+
+func (c *QuayClient) DeleteOrganizationTeam(ctx context.Context, orgName string, teamName string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organization/%s/team/%s", orgName, teamName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetOrganizationTeamMembers(ctx context.Context, orgName string, teamName string) (*http.Response, TeamMembers, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/team/%s/members", orgName, teamName), nil)
+	if err != nil {
+		return nil, TeamMembers{}, err
+	}
+	// Members that have been invited but not yet joined are included so they are not invited again
+	req.URL.RawQuery = url.Values{"includePending": []string{"true"}}.Encode()
+
+	var members TeamMembers
+	resp, err := c.do(req, &members)
+
+	return resp, members, err
+}
+
+func (c *QuayClient) AddOrganizationTeamMember(ctx context.Context, orgName string, teamName string, memberName string) (*http.Response, TeamMember, error) {
+	req, err := c.newRequest(ctx, "PUT", fmt.Sprintf("/api/v1/organization/%s/team/%s/members/%s", orgName, teamName, memberName), nil)
+	if err != nil {
+		return nil, TeamMember{}, err
+	}
+	var member TeamMember
+	resp, err := c.do(req, &member)
+
+	return resp, member, err
+}
+
+func (c *QuayClient) RemoveOrganizationTeamMember(ctx context.Context, orgName string, teamName string, memberName string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organization/%s/team/%s/members/%s", orgName, teamName, memberName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) GetOrganizationTeamPermissions(ctx context.Context, orgName string, teamName string) (*http.Response, TeamPermissions, error) {
+	req, err := c.newRequest(ctx, "GET", fmt.Sprintf("/api/v1/organization/%s/team/%s/permissions", orgName, teamName), nil)
+	if err != nil {
+		return nil, TeamPermissions{}, err
+	}
+	var permissions TeamPermissions
+	resp, err := c.do(req, &permissions)
+
+	return resp, permissions, err
+}
+
+func (c *QuayClient) EnableOrganizationTeamSync(ctx context.Context, orgName string, teamName string, config map[string]string) (*http.Response, StringValue, error) {
+	req, err := c.newRequest(ctx, "POST", fmt.Sprintf("/api/v1/organization/%s/team/%s/syncing", orgName, teamName), config)
+	if err != nil {
+		return nil, StringValue{}, err
+	}
+	var syncResponse StringValue
+	resp, err := c.do(req, &syncResponse)
+
+	return resp, syncResponse, err
+}
+
+func (c *QuayClient) DisableOrganizationTeamSync(ctx context.Context, orgName string, teamName string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organization/%s/team/%s/syncing", orgName, teamName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *QuayClient) newFileUploadRequest(ctx context.Context, method, path string, fileName string, content []byte) (*http.Request, error) {
 	rel := &url.URL{Path: path}
 	u := c.BaseURL.ResolveReference(rel)
 
@@ -212,19 +1035,20 @@ func (c *QuayClient) newFileUploadRequest(method, path string, fileName string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest(method, u.String(), body)
-	req.SetBasicAuth(c.Username, c.Password)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	c.authenticate(req)
+
 	req.Header.Add("Content-Type", writer.FormDataContentType())
 	req.Header.Add("Accept", "application/json")
 	return req, nil
 
 }
 
-func (c *QuayClient) newRequest(method, path string, body interface{}) (*http.Request, error) {
+func (c *QuayClient) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	rel := &url.URL{Path: path}
 	u := c.BaseURL.ResolveReference(rel)
 	var buf io.ReadWriter
@@ -235,24 +1059,72 @@ func (c *QuayClient) newRequest(method, path string, body interface{}) (*http.Re
 			return nil, err
 		}
 	}
-	req, err := http.NewRequest(method, u.String(), buf)
-	req.SetBasicAuth(c.Username, c.Password)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
+
+	c.authenticate(req)
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 	req.Header.Set("Accept", "application/json")
 	return req, nil
 }
+
+// authenticate adds the credentials of the client to the request
+// Requests of a signed in session carry its CSRF token along with its cookie while an access token is preferred over basic authentication otherwise
+func (c *QuayClient) authenticate(req *http.Request) {
+
+	switch {
+	case c.session:
+		req.Header.Set("X-CSRF-Token", c.csrfToken)
+	case c.Token != "":
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	default:
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+}
+
+// do sends the request, retrying transient failures according to the retry policy of the client, and decodes the response into v
 func (c *QuayClient) do(req *http.Request, v interface{}) (*http.Response, error) {
-	resp, err := c.httpClient.Do(req)
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(req, v)
+		if err == nil || attempt >= c.Retry.MaxAttempts || !isRetryable(req, resp, err) || !c.wait(req.Context(), attempt) {
+			return resp, err
+		}
+
+		// The body was consumed by the previous attempt
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// send performs a single attempt of the request
+func (c *QuayClient) send(req *http.Request, v interface{}) (*http.Response, error) {
+	ctx, cancel := c.requestContext(req.Context())
+	defer cancel()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if !isSuccessful(resp) {
+		return resp, newAPIError(resp)
+	}
+
+	// Responses without content such as those returned for deletions are not decoded
+	if v == nil {
+		return resp, nil
+	}
+
 	if _, ok := v.(*StringValue); ok {
 		responseData, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
@@ -267,11 +1139,26 @@ func (c *QuayClient) do(req *http.Request, v interface{}) (*http.Response, error
 	return resp, err
 }
 
+// nextPageQuery returns the query requesting the page identified by the token returned along with the previous page
+func nextPageQuery(nextPage string) url.Values {
+	query := url.Values{}
+	if nextPage != "" {
+		query.Set("next_page", nextPage)
+	}
+	return query
+}
+
+func isSuccessful(resp *http.Response) bool {
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+}
+
 func NewClient(httpClient *http.Client, baseUrl string, username string, password string) *QuayClient {
 	quayClient := QuayClient{
-		httpClient: httpClient,
-		Username:   username,
-		Password:   password,
+		httpClient:     httpClient,
+		Username:       username,
+		Password:       password,
+		RequestTimeout: DefaultRequestTimeout,
+		Retry:          DefaultRetryPolicy,
 	}
 
 	quayClient.BaseURL, _ = url.Parse(baseUrl)
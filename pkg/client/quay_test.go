@@ -0,0 +1,414 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client for a server answering every request with the provided handler
+func newTestClient(handler http.HandlerFunc) (*QuayClient, *httptest.Server) {
+
+	server := httptest.NewServer(handler)
+
+	client := NewClient(server.Client(), server.URL, "quay", "password")
+	client.Retry.InitialBackoff = time.Millisecond
+	client.Retry.MaxBackoff = time.Millisecond
+
+	return client, server
+}
+
+func TestRequestAuthentication(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		_, _, ok := r.BasicAuth()
+
+		assert.False(t, ok)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+
+		json.NewEncoder(w).Encode(User{Username: "quay"})
+	})
+	defer server.Close()
+
+	client.Token = "token"
+
+	resp, user, err := client.GetUser(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "quay", user.Username)
+}
+
+func TestSignIn(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		_, err := r.Cookie("_csrf_token")
+		signedIn := err == nil
+
+		switch r.URL.Path {
+		case "/csrf_token":
+			if signedIn {
+				json.NewEncoder(w).Encode(CSRFToken{CSRFToken: "session"})
+			} else {
+				json.NewEncoder(w).Encode(CSRFToken{CSRFToken: "anonymous"})
+			}
+		case "/api/v1/signin":
+			request := SigninRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+
+			assert.Equal(t, "quay", request.Username)
+			assert.Equal(t, "password", request.Password)
+			assert.Equal(t, "anonymous", r.Header.Get("X-CSRF-Token"))
+
+			http.SetCookie(w, &http.Cookie{Name: "_csrf_token", Value: "session", Path: "/"})
+			json.NewEncoder(w).Encode(map[string]bool{"success": true})
+		case "/api/v1/user/":
+			_, _, ok := r.BasicAuth()
+
+			assert.False(t, ok)
+			assert.True(t, signedIn)
+			assert.Equal(t, "session", r.Header.Get("X-CSRF-Token"))
+
+			json.NewEncoder(w).Encode(User{Username: "quay"})
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	_, session, err := client.SignIn(context.TODO())
+
+	assert.NoError(t, err)
+
+	_, user, err := session.GetUser(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "quay", user.Username)
+}
+
+func TestInvalidRequest(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	defer server.Close()
+
+	_, err := client.newRequest(context.TODO(), "INVALID METHOD", "/api/v1/user/", nil)
+
+	assert.Error(t, err)
+
+	_, err = client.newFileUploadRequest(context.TODO(), "INVALID METHOD", "/api/v1/superuser/config/file/ssl.cert", "ssl.cert", []byte("certificate"))
+
+	assert.Error(t, err)
+}
+
+func TestGetAllRepositories(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, "/api/v1/repository", r.URL.Path)
+		assert.Equal(t, "example", r.URL.Query().Get("namespace"))
+
+		switch r.URL.Query().Get("next_page") {
+		case "":
+			json.NewEncoder(w).Encode(Repositories{Repositories: []Repository{{Namespace: "example", Name: "first"}}, NextPage: "token"})
+		case "token":
+			json.NewEncoder(w).Encode(Repositories{Repositories: []Repository{{Namespace: "example", Name: "second"}}})
+		}
+	})
+	defer server.Close()
+
+	resp, repositories, err := client.GetAllRepositories(context.TODO(), "example")
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []Repository{{Namespace: "example", Name: "first"}, {Namespace: "example", Name: "second"}}, repositories)
+}
+
+func TestGetAllRepositoryTags(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, "/api/v1/repository/example/app/tag/", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("onlyActiveTags"))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		json.NewEncoder(w).Encode(Tags{Tags: []Tag{{Name: "v" + strconv.Itoa(page)}}, Page: page, HasAdditional: page < 3})
+	})
+	defer server.Close()
+
+	resp, tags, err := client.GetAllRepositoryTags(context.TODO(), "example", "app")
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []Tag{{Name: "v1"}, {Name: "v2"}, {Name: "v3"}}, tags)
+}
+
+func TestGetAllOrganizationLogs(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, "/api/v1/organization/example/logs", r.URL.Path)
+
+		switch r.URL.Query().Get("next_page") {
+		case "":
+			json.NewEncoder(w).Encode(Logs{Logs: []LogEntry{{Kind: "push_repo", Performer: &LogPerformer{Name: "quay", Kind: "user"}}}, NextPage: "token"})
+		case "token":
+			json.NewEncoder(w).Encode(Logs{Logs: []LogEntry{{Kind: "create_repo"}}})
+		}
+	})
+	defer server.Close()
+
+	_, entries, err := client.GetAllOrganizationLogs(context.TODO(), "example")
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "push_repo", entries[0].Kind)
+	assert.Equal(t, "quay", entries[0].Performer.Name)
+	assert.Equal(t, "create_repo", entries[1].Kind)
+}
+
+func TestPaginationStopsOnUnsuccessfulResponse(t *testing.T) {
+
+	requests := 0
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		requests++
+
+		if requests > 1 {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error_message": "Forbidden"}`))
+			return
+		}
+
+		json.NewEncoder(w).Encode(Repositories{Repositories: []Repository{{Name: "first"}}, NextPage: "token"})
+	})
+	defer server.Close()
+
+	resp, repositories, err := client.GetAllRepositories(context.TODO(), "example")
+
+	assert.True(t, IsForbidden(err))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Nil(t, repositories)
+	assert.Equal(t, 2, requests)
+}
+
+func TestChangeRepositoryTag(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/repository/example/app/tag/latest", r.URL.Path)
+
+		request := TagUpdateRequest{}
+		json.NewDecoder(r.Body).Decode(&request)
+		assert.Equal(t, "sha256:digest", request.ManifestDigest)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`"Updated"`))
+	})
+	defer server.Close()
+
+	resp, value, err := client.ChangeRepositoryTag(context.TODO(), "example", "app", "latest", TagUpdateRequest{ManifestDigest: "sha256:digest"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, `"Updated"`, value.Value)
+}
+
+func TestSuperuserListings(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		switch r.URL.Path {
+		case "/api/v1/superuser/users/":
+			json.NewEncoder(w).Encode(Users{Users: []User{{Username: "quay", SuperUser: true, Enabled: true}}})
+		case "/api/v1/superuser/organizations/":
+			json.NewEncoder(w).Encode(Organizations{Organizations: []Organization{{Name: "example"}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	_, users, err := client.GetSuperuserUsers(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []User{{Username: "quay", SuperUser: true, Enabled: true}}, users.Users)
+
+	_, organizations, err := client.GetSuperuserOrganizations(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Organization{{Name: "example"}}, organizations.Organizations)
+}
+
+func TestDeleteRequestsAreNotDecoded(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v1/organization/example/prototypes/1234", r.URL.Path)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	resp, err := client.DeleteOrganizationPrototype(context.TODO(), "example", "1234")
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestUnsuccessfulResponsesReturnAPIError(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/organization/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status": 404, "error_message": "Not Found", "title": "not_found", "error_type": "not_found", "detail": "Not Found"}`))
+		case "/api/v1/superuser/users/":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status": 401, "error_message": "Requires authentication", "error_type": "invalid_token", "detail": "Requires authentication"}`))
+		default:
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html>Bad Gateway</html>"))
+		}
+	})
+	defer server.Close()
+
+	resp, _, err := client.GetOrganization(context.TODO(), "missing")
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsUnauthorized(err))
+	assert.Equal(t, "Quay API request GET /api/v1/organization/missing failed with status 404 (not_found): Not Found", err.Error())
+
+	_, _, err = client.GetSuperuserUsers(context.TODO())
+
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, "invalid_token", err.(*APIError).ErrorType)
+
+	_, err = client.DeleteOrganizationPrototype(context.TODO(), "example", "1234")
+
+	assert.True(t, IsStatus(err, http.StatusBadGateway))
+	assert.Equal(t, "<html>Bad Gateway</html>", err.(*APIError).Message)
+}
+
+func TestUnavailableResponsesAreRetried(t *testing.T) {
+
+	requests := 0
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		requests++
+
+		request := OrganizationCreateRequest{}
+		json.NewDecoder(r.Body).Decode(&request)
+		assert.Equal(t, "example", request.Name)
+
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`"Created"`))
+	})
+	defer server.Close()
+
+	resp, _, err := client.CreateOrganization(context.TODO(), OrganizationCreateRequest{Name: "example"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, 3, requests)
+}
+
+func TestRetriesAreBounded(t *testing.T) {
+
+	requests := 0
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	_, _, err := client.GetOrganization(context.TODO(), "example")
+
+	assert.True(t, IsStatus(err, http.StatusBadGateway))
+	assert.Equal(t, DefaultRetryPolicy.MaxAttempts, requests)
+}
+
+func TestFailedConnectionsAreOnlyRetriedForIdempotentRequests(t *testing.T) {
+
+	requests := 0
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+
+		requests++
+
+		// The connection is closed without any response for the first request of each call
+		if requests%2 == 1 {
+			connection, _, _ := w.(http.Hijacker).Hijack()
+			connection.Close()
+			return
+		}
+
+		json.NewEncoder(w).Encode(Organization{Name: "example"})
+	})
+	defer server.Close()
+
+	_, organization, err := client.GetOrganization(context.TODO(), "example")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "example", organization.Name)
+	assert.Equal(t, 2, requests)
+
+	_, _, err = client.CreateOrganization(context.TODO(), OrganizationCreateRequest{Name: "example"})
+
+	assert.Error(t, err)
+	assert.False(t, IsAPIError(err))
+	assert.Equal(t, 3, requests)
+}
+
+func TestRequestTimeout(t *testing.T) {
+
+	client, server := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	defer server.Close()
+
+	client.RequestTimeout = 10 * time.Millisecond
+	client.Retry.MaxAttempts = 2
+
+	_, _, err := client.GetOrganization(context.TODO(), "example")
+
+	assert.Error(t, err)
+	assert.False(t, IsAPIError(err))
+
+	// The deadline of the caller takes precedence and is not retried once exceeded
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err = client.GetOrganization(ctx, "example")
+
+	assert.Error(t, err)
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+	assert.True(t, time.Since(start) < time.Second)
+}
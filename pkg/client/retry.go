@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultRequestTimeout bounds each attempt of a request whose context has no deadline
+	DefaultRequestTimeout = 30 * time.Second
+)
+
+// RetryPolicy controls how requests failing with a transient error are retried
+// Idempotent requests are retried when they could not be sent or received no response. Any request is retried when the router responds that Quay is unavailable, which happens while its pods are starting
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request including the first one
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry which is doubled on each subsequent retry
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum wait between attempts
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of clients returned by NewClient
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     8 * time.Second,
+}
+
+// backoff returns the wait following the provided attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return wait
+}
+
+// isRetryable determines whether the failed attempt of the request can be retried
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+
+	// The caller gave up on the request
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	// The router did not reach Quay so the request was not processed
+	if IsStatus(err, http.StatusBadGateway) || IsStatus(err, http.StatusServiceUnavailable) {
+		return true
+	}
+
+	// Other unsuccessful responses and responses which could not be decoded are final
+	if resp != nil || IsAPIError(err) {
+		return false
+	}
+
+	return isIdempotent(req.Method)
+}
+
+// isIdempotent determines whether requests with the method can be repeated without additional effects
+func isIdempotent(method string) bool {
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// requestContext returns the context of an attempt of a request which is bounded by the request timeout of the client unless the caller provided a deadline
+func (c *QuayClient) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+
+	if _, ok := ctx.Deadline(); ok || c.RequestTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.RequestTimeout)
+}
+
+// wait blocks for the backoff following the attempt and returns false when the request is cancelled in the meantime
+func (c *QuayClient) wait(ctx context.Context, attempt int) bool {
+
+	timer := time.NewTimer(c.Retry.backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
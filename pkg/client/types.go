@@ -54,6 +54,338 @@ type KeyCreationResponse struct {
 	Service    string `json:"service"`
 }
 
+type Organization struct {
+	Name           string          `json:"name"`
+	Email          string          `json:"email,omitempty"`
+	IsAdmin        bool            `json:"is_admin,omitempty"`
+	TagExpirationS int             `json:"tag_expiration_s,omitempty"`
+	Teams          map[string]Team `json:"teams,omitempty"`
+}
+
+type OrganizationCreateRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+type OrganizationUpdateRequest struct {
+	Email string `json:"email,omitempty"`
+}
+
+type Organizations struct {
+	Organizations []Organization `json:"organizations"`
+}
+
+type OrganizationMemberTeam struct {
+	Name string `json:"name"`
+}
+
+type OrganizationMember struct {
+	Name    string                   `json:"name"`
+	Kind    string                   `json:"kind"`
+	IsRobot bool                     `json:"is_robot,omitempty"`
+	Teams   []OrganizationMemberTeam `json:"teams,omitempty"`
+}
+
+type OrganizationMembers struct {
+	Members []OrganizationMember `json:"members"`
+}
+
+type Application struct {
+	Name           string `json:"name"`
+	ClientID       string `json:"client_id"`
+	ClientSecret   string `json:"client_secret,omitempty"`
+	RedirectURI    string `json:"redirect_uri,omitempty"`
+	ApplicationURI string `json:"application_uri,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+type Applications struct {
+	Applications []Application `json:"applications"`
+}
+
+type ApplicationCreateRequest struct {
+	Name           string `json:"name"`
+	RedirectURI    string `json:"redirect_uri,omitempty"`
+	ApplicationURI string `json:"application_uri,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+type SigninRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type CSRFToken struct {
+	CSRFToken string `json:"csrf_token"`
+}
+
+type Prototype struct {
+	ID       string            `json:"id,omitempty"`
+	Role     string            `json:"role"`
+	Delegate PrototypeDelegate `json:"delegate"`
+}
+
+type PrototypeDelegate struct {
+	Name    string `json:"name"`
+	Kind    string `json:"kind"`
+	IsRobot bool   `json:"is_robot,omitempty"`
+}
+
+type Prototypes struct {
+	Prototypes []Prototype `json:"prototypes"`
+}
+
+type PrototypeCreateRequest struct {
+	Role     string            `json:"role"`
+	Delegate PrototypeDelegate `json:"delegate"`
+}
+
+type User struct {
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Verified  bool   `json:"verified"`
+	SuperUser bool   `json:"super_user"`
+	Enabled   bool   `json:"enabled"`
+}
+
+type Users struct {
+	Users []User `json:"users"`
+}
+
+type UserCreateRequest struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+type UserUpdateRequest struct {
+	Email    string `json:"email,omitempty"`
+	Password string `json:"password,omitempty"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+}
+
+type Repository struct {
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsPublic    bool   `json:"is_public"`
+	Kind        string `json:"kind,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+type RepositoryCreateRequest struct {
+	Namespace   string `json:"namespace"`
+	Repository  string `json:"repository"`
+	Visibility  string `json:"visibility"`
+	Description string `json:"description"`
+	RepoKind    string `json:"repo_kind,omitempty"`
+}
+
+type RepositoryUpdateRequest struct {
+	Description string `json:"description"`
+}
+
+type RepositoryVisibilityRequest struct {
+	Visibility string `json:"visibility"`
+}
+
+type RepositoryNotification struct {
+	UUID             string                 `json:"uuid"`
+	Title            string                 `json:"title"`
+	Event            string                 `json:"event"`
+	Method           string                 `json:"method"`
+	Config           map[string]interface{} `json:"config"`
+	EventConfig      map[string]interface{} `json:"event_config"`
+	NumberOfFailures int                    `json:"number_of_failures"`
+}
+
+type RepositoryNotifications struct {
+	Notifications []RepositoryNotification `json:"notifications"`
+}
+
+type RepositoryNotificationCreateRequest struct {
+	Event       string            `json:"event"`
+	Method      string            `json:"method"`
+	Config      map[string]string `json:"config"`
+	EventConfig map[string]string `json:"eventConfig"`
+	Title       string            `json:"title"`
+}
+
+type Repositories struct {
+	Repositories []Repository `json:"repositories"`
+	NextPage     string       `json:"next_page,omitempty"`
+}
+
+type Tag struct {
+	Name           string `json:"name"`
+	StartTS        int64  `json:"start_ts"`
+	LastModified   string `json:"last_modified,omitempty"`
+	ManifestDigest string `json:"manifest_digest,omitempty"`
+}
+
+type Tags struct {
+	Tags          []Tag `json:"tags"`
+	Page          int   `json:"page"`
+	HasAdditional bool  `json:"has_additional"`
+}
+
+type TagUpdateRequest struct {
+	ManifestDigest string `json:"manifest_digest"`
+}
+
+type RepositoryStateRequest struct {
+	State string `json:"state"`
+}
+
+type RepositoryPermission struct {
+	Name    string `json:"name"`
+	Role    string `json:"role"`
+	IsRobot bool   `json:"is_robot,omitempty"`
+}
+
+type RepositoryPermissions struct {
+	Permissions map[string]RepositoryPermission `json:"permissions"`
+}
+
+type RepositoryPermissionRequest struct {
+	Role string `json:"role"`
+}
+
+type RepositoryMirror struct {
+	IsEnabled                bool                           `json:"is_enabled"`
+	MirrorType               string                         `json:"mirror_type,omitempty"`
+	ExternalReference        string                         `json:"external_reference"`
+	ExternalRegistryUsername *string                        `json:"external_registry_username,omitempty"`
+	ExternalRegistryConfig   RepositoryMirrorRegistryConfig `json:"external_registry_config"`
+	SyncInterval             int                            `json:"sync_interval"`
+	SyncStartDate            string                         `json:"sync_start_date,omitempty"`
+	SyncExpirationDate       string                         `json:"sync_expiration_date,omitempty"`
+	SyncRetriesRemaining     int                            `json:"sync_retries_remaining,omitempty"`
+	SyncStatus               string                         `json:"sync_status,omitempty"`
+	RootRule                 RepositoryMirrorRule           `json:"root_rule"`
+	RobotUsername            string                         `json:"robot_username"`
+}
+
+type RepositoryMirrorRegistryConfig struct {
+	VerifyTLS bool `json:"verify_tls"`
+}
+
+type RepositoryMirrorRule struct {
+	RuleKind  string   `json:"rule_kind"`
+	RuleValue []string `json:"rule_value"`
+}
+
+type RepositoryMirrorRequest struct {
+	IsEnabled                bool                           `json:"is_enabled"`
+	ExternalReference        string                         `json:"external_reference"`
+	ExternalRegistryUsername *string                        `json:"external_registry_username,omitempty"`
+	ExternalRegistryPassword *string                        `json:"external_registry_password,omitempty"`
+	ExternalRegistryConfig   RepositoryMirrorRegistryConfig `json:"external_registry_config"`
+	SyncInterval             int                            `json:"sync_interval"`
+	SyncStartDate            string                         `json:"sync_start_date,omitempty"`
+	RootRule                 RepositoryMirrorRule           `json:"root_rule"`
+	RobotUsername            string                         `json:"robot_username"`
+}
+
+type RobotAccount struct {
+	Name        string `json:"name"`
+	Token       string `json:"token,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type RobotAccounts struct {
+	Robots []RobotAccount `json:"robots"`
+}
+
+type RobotAccountCreateRequest struct {
+	Description string `json:"description,omitempty"`
+}
+
+type RobotAccountPermissionRepository struct {
+	Name     string `json:"name"`
+	IsPublic bool   `json:"is_public"`
+}
+
+type RobotAccountPermission struct {
+	Repository RobotAccountPermissionRepository `json:"repository"`
+	Role       string                           `json:"role"`
+}
+
+type RobotAccountPermissions struct {
+	Permissions []RobotAccountPermission `json:"permissions"`
+}
+
+type Team struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Role        string `json:"role"`
+	MemberCount int    `json:"member_count,omitempty"`
+}
+
+type TeamUpdateRequest struct {
+	Role        string `json:"role"`
+	Description string `json:"description,omitempty"`
+}
+
+type TeamMember struct {
+	Name    string `json:"name"`
+	Kind    string `json:"kind,omitempty"`
+	IsRobot bool   `json:"is_robot,omitempty"`
+	Invited bool   `json:"invited,omitempty"`
+}
+
+type TeamSyncService struct {
+	Service string `json:"service"`
+}
+
+type TeamSyncStatus struct {
+	Service     string            `json:"service"`
+	Config      map[string]string `json:"config,omitempty"`
+	LastUpdated string            `json:"last_updated,omitempty"`
+}
+
+type TeamMembers struct {
+	Name    string           `json:"name"`
+	Members []TeamMember     `json:"members"`
+	CanEdit bool             `json:"can_edit,omitempty"`
+	CanSync *TeamSyncService `json:"can_sync,omitempty"`
+	Synced  *TeamSyncStatus  `json:"synced,omitempty"`
+}
+
+type TeamPermissionRepository struct {
+	Name     string `json:"name"`
+	IsPublic bool   `json:"is_public"`
+}
+
+type TeamPermission struct {
+	Repository TeamPermissionRepository `json:"repository"`
+	Role       string                   `json:"role"`
+}
+
+type TeamPermissions struct {
+	Permissions []TeamPermission `json:"permissions"`
+}
+
+type LogPerformer struct {
+	Name    string `json:"name"`
+	Kind    string `json:"kind"`
+	IsRobot bool   `json:"is_robot,omitempty"`
+}
+
+type LogEntry struct {
+	Kind      string                 `json:"kind"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	IP        string                 `json:"ip,omitempty"`
+	DateTime  string                 `json:"datetime"`
+	Performer *LogPerformer          `json:"performer,omitempty"`
+}
+
+type Logs struct {
+	StartTime string     `json:"start_time,omitempty"`
+	EndTime   string     `json:"end_time,omitempty"`
+	Logs      []LogEntry `json:"logs"`
+	NextPage  string     `json:"next_page,omitempty"`
+}
+
 type StringValue struct {
 	Value string
 }
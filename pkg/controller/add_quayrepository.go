@@ -0,0 +1,10 @@
+package controller
+
+import (
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayrepository"
+)
+
+func init() {
+	// AddToManagerFuncs is a list of functions to create controllers and add them to a manager.
+	AddToManagerFuncs = append(AddToManagerFuncs, quayrepository.Add)
+}
@@ -1,10 +1,10 @@
 package controller
 
 import (
-	"github.com/redhat-cop/quay-operator/pkg/controller/secret"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayteam"
 )
 
 func init() {
 	// AddToManagerFuncs is a list of functions to create controllers and add them to a manager.
-	AddToManagerFuncs = append(AddToManagerFuncs, secret.Add)
+	AddToManagerFuncs = append(AddToManagerFuncs, quayteam.Add)
 }
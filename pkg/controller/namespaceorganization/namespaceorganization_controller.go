@@ -0,0 +1,507 @@
+package namespaceorganization
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	"github.com/redhat-cop/quay-operator/pkg/k8sutils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// robotAccountRole is the role granted by default to the robot account on the repositories of the organization
+const robotAccountRole = "read"
+
+// Add creates a new NamespaceOrganization Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+
+	watchNamespace, err := k8sutil.GetWatchNamespace()
+	if err != nil {
+		return err
+	}
+
+	// Namespaces can only be watched when the operator watches the entire cluster
+	if len(k8sutils.ParseWatchNamespaces(watchNamespace)) > 0 {
+		logging.Log.Info("Namespace organizations are disabled as the operator does not watch all namespaces")
+		return nil
+	}
+
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileNamespaceOrganization {
+	return &ReconcileNamespaceOrganization{
+		reconcilerBase: util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("namespaceorganization-controller")),
+	}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("namespaceorganization-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource Namespace
+	err = c.Watch(&source.Kind{Type: &corev1.Namespace{}}, &handler.EnqueueRequestForObject{}, organizationBridgeChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the pull secrets of the namespaces
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) []reconcile.Request {
+
+			if obj.Meta.GetName() != constants.OrganizationBridgePullSecretName {
+				return nil
+			}
+
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.Meta.GetNamespace()}}}
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the service accounts linked to the pull secrets
+	err = c.Watch(&source.Kind{Type: &corev1.ServiceAccount{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) []reconcile.Request {
+
+			for _, serviceAccountName := range constants.OrganizationBridgeServiceAccounts {
+				if obj.Meta.GetName() == serviceAccountName {
+					return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.Meta.GetNamespace()}}}
+				}
+			}
+
+			return nil
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystems becoming available
+	return c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayEcosystem{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: &quayEcosystemMapper{client: mgr.GetClient()},
+	}, quayapi.QuayEcosystemReadyChangedPredicate{})
+}
+
+// organizationBridgeChangedPredicate fires an update event only when the properties of a namespace affecting its organization change
+type organizationBridgeChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Update determines whether the label, deletion policy, owner, finalizers or deletion of the namespace changed
+func (organizationBridgeChangedPredicate) Update(e event.UpdateEvent) bool {
+
+	if e.MetaOld == nil || e.MetaNew == nil {
+		return false
+	}
+
+	return e.MetaOld.GetLabels()[constants.OrganizationBridgeLabelKey] != e.MetaNew.GetLabels()[constants.OrganizationBridgeLabelKey] ||
+		e.MetaOld.GetAnnotations()[constants.OrganizationDeletionPolicyAnnotationKey] != e.MetaNew.GetAnnotations()[constants.OrganizationDeletionPolicyAnnotationKey] ||
+		e.MetaOld.GetAnnotations()[constants.OrganizationOwnerAnnotationKey] != e.MetaNew.GetAnnotations()[constants.OrganizationOwnerAnnotationKey] ||
+		!reflect.DeepEqual(e.MetaOld.GetFinalizers(), e.MetaNew.GetFinalizers()) ||
+		!reflect.DeepEqual(e.MetaOld.GetDeletionTimestamp(), e.MetaNew.GetDeletionTimestamp())
+}
+
+// quayEcosystemMapper maps a QuayEcosystem to requests for the namespaces referencing it
+type quayEcosystemMapper struct {
+	client client.Client
+}
+
+// Map returns a request for each namespace whose label references the QuayEcosystem
+func (m *quayEcosystemMapper) Map(obj handler.MapObject) []reconcile.Request {
+
+	namespaces := &corev1.NamespaceList{}
+
+	err := m.client.List(context.TODO(), namespaces, client.MatchingLabels{constants.OrganizationBridgeLabelKey: obj.Meta.GetNamespace() + "." + obj.Meta.GetName()})
+
+	if err != nil {
+		logging.Log.Error(err, "Failed to List Namespaces Referencing QuayEcosystem", "Namespace", obj.Meta.GetNamespace(), "Name", obj.Meta.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for _, namespace := range namespaces.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: namespace.Name}})
+	}
+
+	return requests
+}
+
+// blank assignment to verify that ReconcileNamespaceOrganization implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileNamespaceOrganization{}
+
+// ReconcileNamespaceOrganization reconciles a Namespace object
+type ReconcileNamespaceOrganization struct {
+	reconcilerBase util.ReconcilerBase
+}
+
+// Reconcile provides a labelled namespace with an organization in Quay and links the pull secret of its robot account to the service accounts of the namespace
+func (r *ReconcileNamespaceOrganization) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Name", request.Name)
+	reqLogger.Info("Reconciling Namespace Organization")
+
+	namespace := &corev1.Namespace{}
+	err := r.reconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	quayEcosystemNamespace, quayEcosystemName, bridged := getQuayEcosystemReference(namespace)
+
+	if util.IsBeingDeleted(namespace) {
+		return r.manageDeletion(namespace, quayEcosystemNamespace, quayEcosystemName, bridged)
+	}
+
+	// Namespaces no longer requesting an organization leave it in Quay
+	if !bridged {
+		if !util.HasFinalizer(namespace, quayapi.QuayResourceFinalizer) {
+			return reconcile.Result{}, nil
+		}
+
+		util.RemoveFinalizer(namespace, quayapi.QuayResourceFinalizer)
+
+		return reconcile.Result{}, r.reconcilerBase.GetClient().Update(context.TODO(), namespace)
+	}
+
+	updated, err := r.manageFinalizer(namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	quayInstance, err := quayapi.GetQuayInstance(r.reconcilerBase.GetClient(), quayEcosystemNamespace, quayEcosystemName)
+	if err != nil {
+		return r.manageError(namespace, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	organizationName := namespace.Name
+
+	// The namespace records its ownership before the organization is created so that only organizations created for it are managed
+	if !isOrganizationOwned(namespace) {
+
+		claimed, err := r.claimOrganization(namespace, quayInstance.QuayClient)
+		if err != nil {
+			return r.manageError(namespace, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+		}
+		if !claimed {
+			return r.manageError(namespace, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Organization %s already exists in Quay and was not created for namespace %s", organizationName, namespace.Name))
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	err = syncOrganization(quayInstance.QuayClient, organizationName)
+	if err != nil {
+		return r.manageError(namespace, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	robotAccount, err := syncRobotAccount(quayInstance.QuayClient, organizationName)
+	if err != nil {
+		return r.manageError(namespace, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = syncDefaultPermission(quayInstance.QuayClient, organizationName, robotAccount.Name)
+	if err != nil {
+		return r.manageError(namespace, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = r.managePullSecret(namespace, quayInstance.Hostname, robotAccount)
+	if err != nil {
+		return r.manageError(namespace, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = r.linkPullSecret(namespace)
+	if err != nil {
+		return r.manageError(namespace, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	return reconcile.Result{RequeueAfter: quayapi.ResyncPeriod}, nil
+}
+
+// getQuayEcosystemReference returns the namespace and name of the QuayEcosystem referenced by the label of the namespace and whether the namespace requests an organization
+func getQuayEcosystemReference(namespace *corev1.Namespace) (string, string, bool) {
+
+	// Namespaces cannot contain dots which separates them from the name of the QuayEcosystem
+	parts := strings.SplitN(namespace.Labels[constants.OrganizationBridgeLabelKey], ".", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+// isDeletionPolicyDelete determines whether the organization is deleted from Quay along with the namespace
+func isDeletionPolicyDelete(namespace *corev1.Namespace) bool {
+	return redhatcopv1alpha1.QuayResourceDeletionPolicy(namespace.Annotations[constants.OrganizationDeletionPolicyAnnotationKey]) == redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy
+}
+
+// isOrganizationOwned determines whether the organization of the namespace was created by the operator in the QuayEcosystem currently referenced by the namespace
+func isOrganizationOwned(namespace *corev1.Namespace) bool {
+
+	owner := namespace.Annotations[constants.OrganizationOwnerAnnotationKey]
+
+	return owner != "" && owner == namespace.Labels[constants.OrganizationBridgeLabelKey]
+}
+
+// claimOrganization records the QuayEcosystem as the owner of the organization of the namespace when the organization does not exist yet
+// Reports whether the organization was claimed
+func (r *ReconcileNamespaceOrganization) claimOrganization(namespace *corev1.Namespace, quayClient *qclient.QuayClient) (bool, error) {
+
+	_, _, err := quayClient.GetOrganization(context.TODO(), namespace.Name)
+
+	if err == nil {
+		return false, nil
+	}
+	if !qclient.IsNotFound(err) {
+		return false, err
+	}
+
+	if namespace.Annotations == nil {
+		namespace.Annotations = map[string]string{}
+	}
+
+	namespace.Annotations[constants.OrganizationOwnerAnnotationKey] = namespace.Labels[constants.OrganizationBridgeLabelKey]
+
+	return true, r.reconcilerBase.GetClient().Update(context.TODO(), namespace)
+}
+
+// manageFinalizer adds or removes the finalizer according to the deletion policy and reports whether the namespace was updated
+func (r *ReconcileNamespaceOrganization) manageFinalizer(namespace *corev1.Namespace) (bool, error) {
+
+	hasFinalizer := util.HasFinalizer(namespace, quayapi.QuayResourceFinalizer)
+	deleteFromQuay := isDeletionPolicyDelete(namespace)
+
+	if hasFinalizer == deleteFromQuay {
+		return false, nil
+	}
+
+	if deleteFromQuay {
+		util.AddFinalizer(namespace, quayapi.QuayResourceFinalizer)
+	} else {
+		util.RemoveFinalizer(namespace, quayapi.QuayResourceFinalizer)
+	}
+
+	return true, r.reconcilerBase.GetClient().Update(context.TODO(), namespace)
+}
+
+// manageDeletion deletes the organization from Quay when required by the deletion policy and removes the finalizer
+func (r *ReconcileNamespaceOrganization) manageDeletion(namespace *corev1.Namespace, quayEcosystemNamespace string, quayEcosystemName string, bridged bool) (reconcile.Result, error) {
+
+	if !util.HasFinalizer(namespace, quayapi.QuayResourceFinalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	if bridged && isDeletionPolicyDelete(namespace) && isOrganizationOwned(namespace) {
+
+		quayInstance, err := quayapi.GetQuayInstance(r.reconcilerBase.GetClient(), quayEcosystemNamespace, quayEcosystemName)
+
+		if err != nil {
+			if !quayapi.IsQuayEcosystemNotReady(err) {
+				return r.manageError(namespace, redhatcopv1alpha1.QuayResourceDeletionFailure, err)
+			}
+
+			// Organizations cannot be deleted without an available Quay instance
+			logging.Log.Info("Skipping deletion from Quay", "Name", namespace.Name, "Reason", err.Error())
+
+		} else if err := deleteOrganization(quayInstance.QuayClient, namespace.Name); err != nil {
+			return r.manageError(namespace, redhatcopv1alpha1.QuayResourceDeletionFailure, err)
+		}
+	}
+
+	util.RemoveFinalizer(namespace, quayapi.QuayResourceFinalizer)
+
+	return reconcile.Result{}, r.reconcilerBase.GetClient().Update(context.TODO(), namespace)
+}
+
+// manageError records the failure as an event of the namespace
+// Namespaces waiting for their QuayEcosystem are retried periodically, namespaces which cannot be synchronized are checked again at the resync period and other failures are retried with backoff
+func (r *ReconcileNamespaceOrganization) manageError(namespace *corev1.Namespace, reason redhatcopv1alpha1.QuayResourceConditionReason, issue error) (reconcile.Result, error) {
+
+	if quayapi.IsQuayEcosystemNotReady(issue) {
+		reason = redhatcopv1alpha1.QuayResourceQuayEcosystemNotReady
+	}
+
+	r.reconcilerBase.GetRecorder().Event(namespace, "Warning", string(reason), issue.Error())
+
+	if quayapi.IsQuayEcosystemNotReady(issue) {
+		return reconcile.Result{RequeueAfter: quayapi.NotReadyRequeuePeriod}, nil
+	}
+
+	if reason == redhatcopv1alpha1.QuayResourceValidationFailure {
+		return reconcile.Result{RequeueAfter: quayapi.ResyncPeriod}, nil
+	}
+
+	return reconcile.Result{}, issue
+}
+
+// syncOrganization creates the organization unless it already exists
+func syncOrganization(quayClient *qclient.QuayClient, organizationName string) error {
+
+	_, _, err := quayClient.GetOrganization(context.TODO(), organizationName)
+
+	if !qclient.IsNotFound(err) {
+		return err
+	}
+
+	logging.Log.Info("Creating Organization", "Name", organizationName, "Organization", organizationName)
+
+	_, _, err = quayClient.CreateOrganization(context.TODO(), qclient.OrganizationCreateRequest{
+		Name: organizationName,
+	})
+
+	return err
+}
+
+// syncRobotAccount creates the robot account of the organization unless it already exists and returns the robot account including its token
+func syncRobotAccount(quayClient *qclient.QuayClient, organizationName string) (qclient.RobotAccount, error) {
+
+	_, robotAccount, err := quayClient.GetOrganizationRobot(context.TODO(), organizationName, constants.OrganizationBridgeRobotAccountName)
+
+	if qclient.IsNotFound(err) {
+		logging.Log.Info("Creating Robot Account", "Name", organizationName, "Robot Account", organizationName+"+"+constants.OrganizationBridgeRobotAccountName)
+
+		_, robotAccount, err = quayClient.CreateOrganizationRobot(context.TODO(), organizationName, constants.OrganizationBridgeRobotAccountName, qclient.RobotAccountCreateRequest{
+			Description: fmt.Sprintf("Pull secret of namespace %s", organizationName),
+		})
+	}
+
+	if err != nil {
+		return qclient.RobotAccount{}, err
+	}
+
+	return robotAccount, nil
+}
+
+// syncDefaultPermission grants the robot account access to the repositories created in the organization unless a default permission already exists for it
+func syncDefaultPermission(quayClient *qclient.QuayClient, organizationName string, robotAccountName string) error {
+
+	_, prototypes, err := quayClient.GetOrganizationPrototypes(context.TODO(), organizationName)
+
+	if err != nil {
+		return err
+	}
+
+	for _, prototype := range prototypes.Prototypes {
+		if prototype.Delegate.Name == robotAccountName {
+			return nil
+		}
+	}
+
+	logging.Log.Info("Creating Default Permission", "Name", organizationName, "Robot Account", robotAccountName, "Role", robotAccountRole)
+
+	_, _, err = quayClient.CreateOrganizationPrototype(context.TODO(), organizationName, qclient.PrototypeCreateRequest{
+		Role: robotAccountRole,
+		Delegate: qclient.PrototypeDelegate{
+			Name:    robotAccountName,
+			Kind:    "user",
+			IsRobot: true,
+		},
+	})
+
+	return err
+}
+
+// managePullSecret publishes the credentials of the robot account as a pull secret for the Quay registry in the namespace
+// The pull secret is removed along with the namespace and is therefore not owned by it
+func (r *ReconcileNamespaceOrganization) managePullSecret(namespace *corev1.Namespace, hostname string, robotAccount qclient.RobotAccount) error {
+
+	secret, err := resources.GetDockerConfigSecretDefinition(metav1.ObjectMeta{
+		Name:      constants.OrganizationBridgePullSecretName,
+		Namespace: namespace.Name,
+	}, hostname, robotAccount.Name, robotAccount.Token)
+
+	if err != nil {
+		return err
+	}
+
+	return r.reconcilerBase.CreateOrUpdateResource(nil, namespace.Name, secret)
+}
+
+// linkPullSecret adds the pull secret to the image pull secrets of the service accounts of the namespace
+// Service accounts that do not exist yet are linked once they are created
+func (r *ReconcileNamespaceOrganization) linkPullSecret(namespace *corev1.Namespace) error {
+
+	for _, serviceAccountName := range constants.OrganizationBridgeServiceAccounts {
+
+		serviceAccount := &corev1.ServiceAccount{}
+		err := r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: namespace.Name, Name: serviceAccountName}, serviceAccount)
+
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if isPullSecretLinked(serviceAccount) {
+			continue
+		}
+
+		logging.Log.Info("Linking Pull Secret", "Name", namespace.Name, "Service Account", serviceAccountName)
+
+		serviceAccount.ImagePullSecrets = append(serviceAccount.ImagePullSecrets, corev1.LocalObjectReference{Name: constants.OrganizationBridgePullSecretName})
+
+		if err := r.reconcilerBase.GetClient().Update(context.TODO(), serviceAccount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isPullSecretLinked determines whether the pull secret is one of the image pull secrets of the service account
+func isPullSecretLinked(serviceAccount *corev1.ServiceAccount) bool {
+
+	for _, imagePullSecret := range serviceAccount.ImagePullSecrets {
+		if imagePullSecret.Name == constants.OrganizationBridgePullSecretName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deleteOrganization deletes the organization from Quay unless it no longer exists
+func deleteOrganization(quayClient *qclient.QuayClient, organizationName string) error {
+
+	_, err := quayClient.DeleteOrganization(context.TODO(), organizationName)
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,238 @@
+package namespaceorganization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var name = "tenant"
+var quayEcosystemNamespace = "quay-enterprise"
+
+// fakeQuay is the state held by the fake Quay server
+type fakeQuay struct {
+	organizations map[string]bool
+	robots        map[string]qclient.RobotAccount
+	prototypes    map[string][]qclient.Prototype
+}
+
+// newQuayServer returns a server implementing the organization, robot account and default permission endpoints of the Quay API
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/organization/"), "/")
+		orgName := parts[0]
+
+		switch {
+		case r.Method == http.MethodPost && orgName == "":
+			request := qclient.OrganizationCreateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			quay.organizations[request.Name] = true
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`"Created"`))
+		case !quay.organizations[orgName]:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_message": "Not Found"}`))
+		case len(parts) == 1 && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(qclient.Organization{Name: orgName})
+		case len(parts) == 1 && r.Method == http.MethodDelete:
+			delete(quay.organizations, orgName)
+			w.WriteHeader(http.StatusNoContent)
+		case parts[1] == "robots":
+			robotName := orgName + "+" + parts[2]
+			robot, found := quay.robots[robotName]
+			switch {
+			case r.Method == http.MethodPut:
+				quay.robots[robotName] = qclient.RobotAccount{Name: robotName, Token: "token"}
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(quay.robots[robotName])
+			case !found:
+				w.WriteHeader(http.StatusNotFound)
+			default:
+				json.NewEncoder(w).Encode(robot)
+			}
+		case parts[1] == "prototypes" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(qclient.Prototypes{Prototypes: quay.prototypes[orgName]})
+		case parts[1] == "prototypes":
+			request := qclient.PrototypeCreateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			prototype := qclient.Prototype{Role: request.Role, Delegate: request.Delegate}
+			quay.prototypes[orgName] = append(quay.prototypes[orgName], prototype)
+			json.NewEncoder(w).Encode(prototype)
+		}
+	})
+}
+
+func newNamespace(deletionPolicy redhatcopv1alpha1.QuayResourceDeletionPolicy) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				constants.OrganizationBridgeLabelKey: quayEcosystemNamespace + "." + testutil.QuayEcosystemName,
+			},
+			Annotations: map[string]string{
+				constants.OrganizationDeletionPolicyAnnotationKey: string(deletionPolicy),
+			},
+		},
+	}
+}
+
+func newServiceAccount(serviceAccountName string) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceAccountName,
+			Namespace: name,
+		},
+	}
+}
+
+func TestReconcileNamespaceOrganization(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{organizations: map[string]bool{}, robots: map[string]qclient.RobotAccount{}, prototypes: map[string][]qclient.Prototype{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	namespace := newNamespace(redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy)
+
+	r := ReconcileNamespaceOrganization{reconcilerBase: testutil.NewReconcilerBase(namespace, testutil.NewReadyQuayEcosystem(t, quayEcosystemNamespace, server), testutil.NewQuayAPITokenSecret(quayEcosystemNamespace), newServiceAccount("default"))}
+
+	// The finalizer is added first when the organization is deleted along with the namespace
+	_, err := testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.Contains(t, namespace.Finalizers, quayapi.QuayResourceFinalizer)
+	assert.Empty(t, quay.organizations)
+
+	// The ownership of the organization is recorded before it is created
+	_, err = testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayEcosystemNamespace+"."+testutil.QuayEcosystemName, namespace.Annotations[constants.OrganizationOwnerAnnotationKey])
+	assert.Empty(t, quay.organizations)
+
+	result, err := testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.True(t, quay.organizations[name])
+	assert.Contains(t, quay.robots, name+"+"+constants.OrganizationBridgeRobotAccountName)
+	assert.Len(t, quay.prototypes[name], 1)
+	assert.Equal(t, name+"+"+constants.OrganizationBridgeRobotAccountName, quay.prototypes[name][0].Delegate.Name)
+
+	secret := &corev1.Secret{}
+	err = r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: name, Name: constants.OrganizationBridgePullSecretName}, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+	assert.Empty(t, secret.OwnerReferences)
+
+	serviceAccount := &corev1.ServiceAccount{}
+	err = r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: name, Name: "default"}, serviceAccount)
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: constants.OrganizationBridgePullSecretName}}, serviceAccount.ImagePullSecrets)
+
+	// Service accounts created later are linked without duplicating existing links
+	assert.NoError(t, r.reconcilerBase.GetClient().Create(context.TODO(), newServiceAccount("builder")))
+
+	_, err = testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.Len(t, quay.prototypes[name], 1)
+
+	for _, serviceAccountName := range constants.OrganizationBridgeServiceAccounts {
+		err = r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: name, Name: serviceAccountName}, serviceAccount)
+		assert.NoError(t, err)
+		assert.Len(t, serviceAccount.ImagePullSecrets, 1)
+	}
+}
+
+func TestReconcileNamespaceOrganizationDeletion(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{organizations: map[string]bool{name: true}, robots: map[string]qclient.RobotAccount{}, prototypes: map[string][]qclient.Prototype{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	deletionTimestamp := metav1.Now()
+
+	namespace := newNamespace(redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy)
+	namespace.Annotations[constants.OrganizationOwnerAnnotationKey] = quayEcosystemNamespace + "." + testutil.QuayEcosystemName
+	namespace.Finalizers = []string{quayapi.QuayResourceFinalizer}
+	namespace.DeletionTimestamp = &deletionTimestamp
+
+	r := ReconcileNamespaceOrganization{reconcilerBase: testutil.NewReconcilerBase(namespace, testutil.NewReadyQuayEcosystem(t, quayEcosystemNamespace, server), testutil.NewQuayAPITokenSecret(quayEcosystemNamespace))}
+
+	_, err := testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, quay.organizations, name)
+	assert.NotContains(t, namespace.Finalizers, quayapi.QuayResourceFinalizer)
+}
+
+func TestReconcileUnlabelledNamespace(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{organizations: map[string]bool{}, robots: map[string]qclient.RobotAccount{}, prototypes: map[string][]qclient.Prototype{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	namespace := newNamespace(redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy)
+	namespace.Labels = nil
+	namespace.Finalizers = []string{quayapi.QuayResourceFinalizer}
+
+	r := ReconcileNamespaceOrganization{reconcilerBase: testutil.NewReconcilerBase(namespace, testutil.NewReadyQuayEcosystem(t, quayEcosystemNamespace, server), testutil.NewQuayAPITokenSecret(quayEcosystemNamespace))}
+
+	// Namespaces opting out keep their organization and no longer wait for its deletion
+	result, err := testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reconcile.Result{}, result)
+	assert.Empty(t, quay.organizations)
+	assert.Empty(t, namespace.Finalizers)
+}
+
+func TestReconcileExistingOrganization(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{organizations: map[string]bool{name: true}, robots: map[string]qclient.RobotAccount{}, prototypes: map[string][]qclient.Prototype{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	namespace := newNamespace(redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy)
+	namespace.Finalizers = []string{quayapi.QuayResourceFinalizer}
+
+	r := ReconcileNamespaceOrganization{reconcilerBase: testutil.NewReconcilerBase(namespace, testutil.NewReadyQuayEcosystem(t, quayEcosystemNamespace, server), testutil.NewQuayAPITokenSecret(quayEcosystemNamespace))}
+
+	// Organizations which were not created for the namespace are left untouched
+	result, err := testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.NotContains(t, namespace.Annotations, constants.OrganizationOwnerAnnotationKey)
+	assert.Empty(t, quay.robots)
+
+	// and are not deleted along with the namespace
+	deletionTimestamp := metav1.Now()
+	namespace.DeletionTimestamp = &deletionTimestamp
+	assert.NoError(t, r.reconcilerBase.GetClient().Update(context.TODO(), namespace))
+
+	_, err = testutil.ReconcileResource(&r, r.reconcilerBase.GetClient(), namespace)
+
+	assert.NoError(t, err)
+	assert.True(t, quay.organizations[name])
+	assert.NotContains(t, namespace.Finalizers, quayapi.QuayResourceFinalizer)
+}
@@ -0,0 +1,178 @@
+package quayapi
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// QuayEcosystemNotReadyError indicates that the referenced QuayEcosystem cannot be used to access Quay yet
+type QuayEcosystemNotReadyError struct {
+	Name    string
+	Message string
+}
+
+func (e *QuayEcosystemNotReadyError) Error() string {
+	return fmt.Sprintf("QuayEcosystem %s is not ready: %s", e.Name, e.Message)
+}
+
+// IsQuayEcosystemNotReady determines whether the error indicates that the referenced QuayEcosystem is not ready
+func IsQuayEcosystemNotReady(err error) bool {
+	_, ok := err.(*QuayEcosystemNotReadyError)
+	return ok
+}
+
+// QuayInstance contains what is needed to access the Quay API of a QuayEcosystem
+type QuayInstance struct {
+	// QuayEcosystem contains the effective specification including the defaults applied by the operator
+	QuayEcosystem *redhatcopv1alpha1.QuayEcosystem
+	QuayClient    *qclient.QuayClient
+	// Hostname is the externally accessible hostname of Quay
+	Hostname string
+}
+
+// GetQuayInstance returns a client for the Quay API of the referenced QuayEcosystem authenticated with the API token minted for the superuser
+func GetQuayInstance(c client.Client, namespace string, quayEcosystemName string) (*QuayInstance, error) {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: quayEcosystemName}, quayEcosystem)
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &QuayEcosystemNotReadyError{Name: quayEcosystemName, Message: "QuayEcosystem not found"}
+		}
+		return nil, err
+	}
+
+	if !quayEcosystem.Status.SetupComplete || quayEcosystem.Status.Hostname == "" {
+		return nil, &QuayEcosystemNotReadyError{Name: quayEcosystemName, Message: "Quay setup has not completed"}
+	}
+
+	// The effective specification contains the defaults applied by the operator
+	effectiveSpec, err := quayEcosystem.GetEffectiveSpec()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if effectiveSpec == nil || effectiveSpec.Quay == nil {
+		return nil, &QuayEcosystemNotReadyError{Name: quayEcosystemName, Message: "QuayEcosystem has not been reconciled"}
+	}
+
+	effectiveQuayEcosystem := quayEcosystem.DeepCopy()
+	effectiveQuayEcosystem.Spec = *effectiveSpec
+
+	username, password, err := GetSuperuserCredentials(c, effectiveQuayEcosystem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := getAPIToken(c, quayEcosystem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	certificates, err := getQuayCertificates(c, effectiveQuayEcosystem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := resources.GetQuayTLSConfig(effectiveQuayEcosystem, certificates, quayEcosystem.Status.Hostname)
+
+	// Basic authentication is only accepted by a few endpoints of the Quay API so requests use the API token
+	quayClient := NewQuayClient(effectiveQuayEcosystem, quayEcosystem.Status.Hostname, username, password, tlsConfig)
+	quayClient.Token = token
+
+	return &QuayInstance{
+		QuayEcosystem: effectiveQuayEcosystem,
+		QuayClient:    quayClient,
+		Hostname:      quayEcosystem.Status.Hostname,
+	}, nil
+}
+
+// NewQuayClient returns a client for the Quay API served at the external hostname of a QuayEcosystem with defaults applied
+func NewQuayClient(quayEcosystem *redhatcopv1alpha1.QuayEcosystem, hostname string, username string, password string, tlsConfig *tls.Config) *qclient.QuayClient {
+
+	// Quay is accessed through its external hostname which only serves plain HTTP when TLS is disabled
+	scheme := "https"
+	if quayEcosystem.Spec.Quay.ExternalAccess != nil && quayEcosystem.Spec.Quay.ExternalAccess.TLS != nil && quayEcosystem.Spec.Quay.ExternalAccess.TLS.Termination == redhatcopv1alpha1.NoneTLSTerminationType {
+		scheme = "http"
+	}
+
+	return qclient.NewClient(resources.GetDefaultHTTPClient(tlsConfig), fmt.Sprintf("%s://%s", scheme, hostname), username, password)
+}
+
+// GetSuperuserCredentials returns the credentials of the superuser created during the setup of Quay
+func GetSuperuserCredentials(c client.Client, quayEcosystem *redhatcopv1alpha1.QuayEcosystem) (string, string, error) {
+
+	if quayEcosystem.Spec.Quay.SuperuserCredentialsSecretName == "" {
+		return constants.InitialQuaySuperuserDefaultUsername, constants.InitialQuaySuperuserDefaultPassword, nil
+	}
+
+	superuserSecret := &corev1.Secret{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: quayEcosystem.Namespace, Name: quayEcosystem.Spec.Quay.SuperuserCredentialsSecretName}, superuserSecret)
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", "", &QuayEcosystemNotReadyError{Name: quayEcosystem.Name, Message: fmt.Sprintf("Superuser credentials secret %s not found", quayEcosystem.Spec.Quay.SuperuserCredentialsSecretName)}
+		}
+		return "", "", err
+	}
+
+	return string(superuserSecret.Data[constants.InitialQuaySuperuserUsernameKey]), string(superuserSecret.Data[constants.InitialQuaySuperuserPasswordKey]), nil
+}
+
+// getAPIToken returns the API token minted for the superuser which is stored in the Secret referenced from the status of the QuayEcosystem
+func getAPIToken(c client.Client, quayEcosystem *redhatcopv1alpha1.QuayEcosystem) (string, error) {
+
+	if quayEcosystem.Status.APIToken == nil || quayEcosystem.Status.APIToken.SecretName == "" {
+		return "", &QuayEcosystemNotReadyError{Name: quayEcosystem.Name, Message: "Quay API token has not been minted, it must be requested in the apiToken property of the QuayEcosystem"}
+	}
+
+	tokenSecret := &corev1.Secret{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: quayEcosystem.Namespace, Name: quayEcosystem.Status.APIToken.SecretName}, tokenSecret)
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", &QuayEcosystemNotReadyError{Name: quayEcosystem.Name, Message: fmt.Sprintf("Quay API token secret %s not found", quayEcosystem.Status.APIToken.SecretName)}
+		}
+		return "", err
+	}
+
+	token := string(tokenSecret.Data[constants.QuayAPITokenSecretKey])
+
+	if token == "" {
+		return "", &QuayEcosystemNotReadyError{Name: quayEcosystem.Name, Message: fmt.Sprintf("Quay API token secret %s does not contain a token", quayEcosystem.Status.APIToken.SecretName)}
+	}
+
+	return token, nil
+}
+
+// getQuayCertificates returns the certificates trusted to access Quay which are stored in the Quay config secret by the QuayEcosystem controller
+func getQuayCertificates(c client.Client, quayEcosystem *redhatcopv1alpha1.QuayEcosystem) ([][]byte, error) {
+
+	configSecret := &corev1.Secret{}
+	err := c.Get(context.TODO(), types.NamespacedName{Namespace: quayEcosystem.Namespace, Name: resources.GetQuaySecretName(quayEcosystem)}, configSecret)
+
+	if err != nil {
+		// Certificates issued by the system roots are still trusted
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return resources.GetQuayConfigSecretCertificates(configSecret), nil
+}
@@ -0,0 +1,170 @@
+package quayapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// QuayResourceFinalizer is added to resources whose objects are deleted from Quay
+	QuayResourceFinalizer = "redhatcop.redhat.io/quay-resource"
+
+	// ResyncPeriod is the interval at which resources are synchronized with Quay to repair drift
+	ResyncPeriod = 5 * time.Minute
+
+	// NotReadyRequeuePeriod is the interval at which resources waiting for their QuayEcosystem are retried
+	NotReadyRequeuePeriod = 30 * time.Second
+)
+
+// ResourceReconciler contains the logic shared by controllers managing resources through the Quay API
+type ResourceReconciler struct {
+	ReconcilerBase util.ReconcilerBase
+}
+
+// GetQuayInstance returns a client for the Quay API of the QuayEcosystem referenced by the resource
+func (r *ResourceReconciler) GetQuayInstance(resource redhatcopv1alpha1.QuayResource) (*QuayInstance, error) {
+	return GetQuayInstance(r.ReconcilerBase.GetClient(), resource.GetNamespace(), resource.GetQuayResourceSpec().QuayEcosystemName)
+}
+
+// ManageFinalizer adds or removes the finalizer according to the deletion policy and reports whether the resource was updated
+func (r *ResourceReconciler) ManageFinalizer(resource redhatcopv1alpha1.QuayResource) (bool, error) {
+
+	hasFinalizer := util.HasFinalizer(resource, QuayResourceFinalizer)
+	deleteFromQuay := resource.GetQuayResourceSpec().IsDeletionPolicyDelete()
+
+	if hasFinalizer == deleteFromQuay {
+		return false, nil
+	}
+
+	if deleteFromQuay {
+		util.AddFinalizer(resource, QuayResourceFinalizer)
+	} else {
+		util.RemoveFinalizer(resource, QuayResourceFinalizer)
+	}
+
+	return true, r.ReconcilerBase.GetClient().Update(context.TODO(), resource)
+}
+
+// ManageDeletion deletes the object from Quay using the provided function when required by the deletion policy and removes the finalizer
+// The finalizer is only removed without deleting the object from Quay when the QuayEcosystem is removed
+func (r *ResourceReconciler) ManageDeletion(resource redhatcopv1alpha1.QuayResource, deleteFunc func(*QuayInstance) error) (reconcile.Result, error) {
+
+	if !util.HasFinalizer(resource, QuayResourceFinalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	quayInstance, err := r.GetQuayInstance(resource)
+
+	if err != nil {
+		if !IsQuayEcosystemNotReady(err) {
+			return r.ManageError(resource, redhatcopv1alpha1.QuayResourceDeletionFailure, err)
+		}
+
+		quayEcosystemRemoved, removedErr := r.isQuayEcosystemRemoved(resource)
+
+		if removedErr != nil {
+			return reconcile.Result{}, removedErr
+		}
+
+		// The deletion is retried until the QuayEcosystem is ready again unless it is being removed along with Quay
+		if !quayEcosystemRemoved {
+			return r.ManageError(resource, redhatcopv1alpha1.QuayResourceDeletionFailure, err)
+		}
+
+		logging.Log.Info("Skipping deletion from Quay", "Namespace", resource.GetNamespace(), "Name", resource.GetName(), "Reason", err.Error())
+
+	} else if err := deleteFunc(quayInstance); err != nil {
+		return r.ManageError(resource, redhatcopv1alpha1.QuayResourceDeletionFailure, err)
+	}
+
+	util.RemoveFinalizer(resource, QuayResourceFinalizer)
+
+	return reconcile.Result{}, r.ReconcilerBase.GetClient().Update(context.TODO(), resource)
+}
+
+// isQuayEcosystemRemoved determines whether the QuayEcosystem referenced by the resource no longer exists or is being deleted
+func (r *ResourceReconciler) isQuayEcosystemRemoved(resource redhatcopv1alpha1.QuayResource) (bool, error) {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: resource.GetNamespace(), Name: resource.GetQuayResourceSpec().QuayEcosystemName}, quayEcosystem)
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return quayEcosystem.GetDeletionTimestamp() != nil, nil
+}
+
+// ManageSuccess records the successful synchronization with Quay and schedules the next synchronization
+func (r *ResourceReconciler) ManageSuccess(resource redhatcopv1alpha1.QuayResource, message string) (reconcile.Result, error) {
+
+	now := metav1.Now()
+
+	status := resource.GetQuayResourceStatus()
+	status.ObservedGeneration = resource.GetGeneration()
+	status.LastSyncTime = &now
+	status.SetCondition(redhatcopv1alpha1.QuayResourceCondition{
+		Type:    redhatcopv1alpha1.QuayResourceSyncedCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  string(redhatcopv1alpha1.QuayResourceSyncSuccess),
+		Message: message,
+	})
+
+	if err := r.ReconcilerBase.GetClient().Status().Update(context.TODO(), resource); err != nil {
+		logging.Log.Error(err, "Failed to update status", "Namespace", resource.GetNamespace(), "Name", resource.GetName())
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: ResyncPeriod}, nil
+}
+
+// ManageError records the failed synchronization with Quay
+// Resources waiting for their QuayEcosystem or with an invalid specification are retried periodically while other failures are retried with backoff
+func (r *ResourceReconciler) ManageError(resource redhatcopv1alpha1.QuayResource, reason redhatcopv1alpha1.QuayResourceConditionReason, issue error) (reconcile.Result, error) {
+
+	if IsQuayEcosystemNotReady(issue) {
+		reason = redhatcopv1alpha1.QuayResourceQuayEcosystemNotReady
+	}
+
+	issue = resources.WrapTLSVerificationError(issue)
+
+	r.ReconcilerBase.GetRecorder().Event(resource, "Warning", string(reason), issue.Error())
+
+	status := resource.GetQuayResourceStatus()
+	status.ObservedGeneration = resource.GetGeneration()
+	status.SetCondition(redhatcopv1alpha1.QuayResourceCondition{
+		Type:    redhatcopv1alpha1.QuayResourceSyncedCondition,
+		Status:  corev1.ConditionFalse,
+		Reason:  string(reason),
+		Message: issue.Error(),
+	})
+
+	if err := r.ReconcilerBase.GetClient().Status().Update(context.TODO(), resource); err != nil {
+		logging.Log.Error(err, "Failed to update status", "Namespace", resource.GetNamespace(), "Name", resource.GetName())
+		return reconcile.Result{}, err
+	}
+
+	if IsQuayEcosystemNotReady(issue) {
+		return reconcile.Result{RequeueAfter: NotReadyRequeuePeriod}, nil
+	}
+
+	// An invalid specification is not retried with backoff as it only becomes valid once the resource or its QuayEcosystem changes
+	if reason == redhatcopv1alpha1.QuayResourceValidationFailure {
+		return reconcile.Result{RequeueAfter: ResyncPeriod}, nil
+	}
+
+	return reconcile.Result{}, issue
+}
@@ -0,0 +1,133 @@
+package quayapi
+
+import (
+	"context"
+	"reflect"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// QuayEcosystemNameIndexField is the field used to index resources by the QuayEcosystem they reference
+	QuayEcosystemNameIndexField = "spec.quayEcosystemName"
+
+	// ReferencedSecretsIndexField is the field used to index resources by the Secrets they reference
+	ReferencedSecretsIndexField = "spec.referencedSecrets"
+)
+
+// WatchQuayEcosystems indexes resources of the provided type by the QuayEcosystem they reference and
+// requests the reconciliation of those resources when their QuayEcosystem becomes ready or changes its hostname or API token
+func WatchQuayEcosystems(mgr manager.Manager, c controller.Controller, resource redhatcopv1alpha1.QuayResource, newList func() runtime.Object) error {
+
+	err := mgr.GetFieldIndexer().IndexField(resource, QuayEcosystemNameIndexField, func(obj runtime.Object) []string {
+
+		quayResource, ok := obj.(redhatcopv1alpha1.QuayResource)
+
+		if !ok || quayResource.GetQuayResourceSpec().QuayEcosystemName == "" {
+			return nil
+		}
+
+		return []string{quayResource.GetQuayResourceSpec().QuayEcosystemName}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayEcosystem{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: &referencingResourceMapper{client: mgr.GetClient(), newList: newList, indexField: QuayEcosystemNameIndexField},
+	}, QuayEcosystemReadyChangedPredicate{})
+}
+
+// WatchReferencedSecrets indexes resources of the provided type by the names of the Secrets returned by referencedSecrets and
+// requests the reconciliation of those resources when one of their Secrets changes
+func WatchReferencedSecrets(mgr manager.Manager, c controller.Controller, resource redhatcopv1alpha1.QuayResource, newList func() runtime.Object, referencedSecrets func(obj runtime.Object) []string) error {
+
+	err := mgr.GetFieldIndexer().IndexField(resource, ReferencedSecretsIndexField, referencedSecrets)
+
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: &referencingResourceMapper{client: mgr.GetClient(), newList: newList, indexField: ReferencedSecretsIndexField},
+	})
+}
+
+// referencingResourceMapper maps an object to requests for the resources referencing it through the indexed field
+type referencingResourceMapper struct {
+	client     client.Client
+	newList    func() runtime.Object
+	indexField string
+}
+
+// Map returns a request for each resource in the namespace of the object that references it
+func (m *referencingResourceMapper) Map(obj handler.MapObject) []reconcile.Request {
+
+	list := m.newList()
+
+	err := m.client.List(context.TODO(), list, client.InNamespace(obj.Meta.GetNamespace()), client.MatchingFields{m.indexField: obj.Meta.GetName()})
+
+	if err != nil {
+		logging.Log.Error(err, "Failed to List Referencing Resources", "Namespace", obj.Meta.GetNamespace(), "Name", obj.Meta.GetName(), "Field", m.indexField)
+		return nil
+	}
+
+	items, err := meta.ExtractList(list)
+
+	if err != nil {
+		logging.Log.Error(err, "Failed to Extract Referencing Resources", "Namespace", obj.Meta.GetNamespace(), "Name", obj.Meta.GetName(), "Field", m.indexField)
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for _, item := range items {
+
+		itemMeta, err := meta.Accessor(item)
+
+		if err != nil {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: itemMeta.GetNamespace(), Name: itemMeta.GetName()}})
+	}
+
+	return requests
+}
+
+// QuayEcosystemReadyChangedPredicate fires an update event only when the setup of a QuayEcosystem completes, its hostname changes
+// or its API token is minted or replaced
+type QuayEcosystemReadyChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Update filters out QuayEcosystem changes that do not affect access to the Quay API
+func (QuayEcosystemReadyChangedPredicate) Update(e event.UpdateEvent) bool {
+
+	oldQuayEcosystem, ok := e.ObjectOld.(*redhatcopv1alpha1.QuayEcosystem)
+	if !ok {
+		return false
+	}
+
+	newQuayEcosystem, ok := e.ObjectNew.(*redhatcopv1alpha1.QuayEcosystem)
+	if !ok {
+		return false
+	}
+
+	return oldQuayEcosystem.Status.SetupComplete != newQuayEcosystem.Status.SetupComplete || oldQuayEcosystem.Status.Hostname != newQuayEcosystem.Status.Hostname ||
+		!reflect.DeepEqual(oldQuayEcosystem.Status.APIToken, newQuayEcosystem.Status.APIToken)
+}
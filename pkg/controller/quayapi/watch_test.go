@@ -0,0 +1,74 @@
+package quayapi
+
+import (
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestQuayEcosystemReadyChangedPredicate(t *testing.T) {
+
+	newQuayEcosystem := func() *redhatcopv1alpha1.QuayEcosystem {
+		return &redhatcopv1alpha1.QuayEcosystem{
+			Status: redhatcopv1alpha1.QuayEcosystemStatus{
+				SetupComplete: true,
+				Hostname:      "quay.example.com",
+				Phase:         redhatcopv1alpha1.QuayEcosystemPhaseRunning,
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		modify   func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem)
+		expected bool
+	}{
+		{
+			name:     "Unchanged",
+			modify:   func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {},
+			expected: false,
+		},
+		{
+			name: "Phase",
+			modify: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Status.Phase = redhatcopv1alpha1.QuayEcosystemPhaseFailed
+			},
+			expected: false,
+		},
+		{
+			name: "SetupComplete",
+			modify: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Status.SetupComplete = false
+			},
+			expected: true,
+		},
+		{
+			name: "Hostname",
+			modify: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Status.Hostname = "registry.example.com"
+			},
+			expected: true,
+		},
+		{
+			name: "APIToken",
+			modify: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Status.APIToken = &redhatcopv1alpha1.APITokenStatus{SecretName: "quay-ecosystem-quay-api-token"}
+			},
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			quayEcosystem := newQuayEcosystem()
+			c.modify(quayEcosystem)
+
+			updated := QuayEcosystemReadyChangedPredicate{}.Update(event.UpdateEvent{ObjectOld: newQuayEcosystem(), ObjectNew: quayEcosystem})
+
+			assert.Equal(t, c.expected, updated)
+		})
+	}
+}
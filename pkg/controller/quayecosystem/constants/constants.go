@@ -43,6 +43,32 @@ const (
 	LabelComponentClairDatabaseValue = "clair-database"
 	// LabelQuayCRKey is the label name of the quay custom resource
 	LabelQuayCRKey = "quay-enterprise-cr"
+	// ConfigChecksumAnnotationKey is the pod template annotation containing the checksum of the configuration of a component
+	ConfigChecksumAnnotationKey = "quay-enterprise-config-checksum"
+	// RegenerateTokenAnnotationKey is the annotation requesting the regeneration of the token of a robot account or of the API token of a QuayEcosystem when its value changes
+	RegenerateTokenAnnotationKey = "quay-enterprise-regenerate-token"
+	// TestNotificationAnnotationKey is the annotation requesting a test notification of a QuayNotification when its value changes
+	TestNotificationAnnotationKey = "quay-enterprise-test-notification"
+	// OrganizationBridgeLabelKey is the namespace label requesting an organization in Quay for the namespace
+	// Its value identifies the QuayEcosystem providing Quay in the form <namespace>.<name>
+	OrganizationBridgeLabelKey = "quay-enterprise-quayecosystem"
+	// OrganizationDeletionPolicyAnnotationKey is the namespace annotation determining whether the organization of the namespace is deleted along with the namespace
+	OrganizationDeletionPolicyAnnotationKey = "quay-enterprise-organization-deletion-policy"
+	// OrganizationOwnerAnnotationKey is the namespace annotation recording the QuayEcosystem in which the organization of the namespace is created by the operator
+	// Organizations which already existed are not managed for the namespace
+	OrganizationOwnerAnnotationKey = "quay-enterprise-organization-owner"
+	// QuayAPITokenDefaultOrganization is the organization containing the OAuth application of the operator when none is specified
+	QuayAPITokenDefaultOrganization = OperatorName
+	// QuayAPITokenApplicationName is the name of the OAuth application the API token is issued for
+	QuayAPITokenApplicationName = OperatorName
+	// QuayAPITokenSecretKey is the key of the secret containing the API token
+	QuayAPITokenSecretKey = "token"
+	// RegistrySmokeTestDefaultOrganization is the organization dedicated to the registry smoke test when none is specified
+	RegistrySmokeTestDefaultOrganization = OperatorName + "-smoke-test"
+	// RegistrySmokeTestRepository is the repository the registry smoke test pushes its image to
+	RegistrySmokeTestRepository = "smoke-test"
+	// RegistrySmokeTestTag is the tag of the image pushed by the registry smoke test
+	RegistrySmokeTestTag = "latest"
 	// AnyUIDSCC is the name of the anyuid SCC
 	AnyUIDSCC = "anyuid"
 	// RedisServiceAccount is the name of the Redis ServiceAccount
@@ -146,6 +172,18 @@ const (
 	InitialQuaySuperuserPasswordKey = "superuser-password"
 	// InitialQuaySuperuserEmailKey represents the key for the superuser email
 	InitialQuaySuperuserEmailKey = "superuser-email"
+	// OrganizationBridgeRobotAccountName is the short name of the robot account created in the organization of a namespace
+	OrganizationBridgeRobotAccountName = "puller"
+	// OrganizationBridgePullSecretName is the name of the Secret containing the credentials of the robot account of a namespace
+	OrganizationBridgePullSecretName = "quay-enterprise-pull-secret"
+	// RepositoryMirrorCredentialsUsernameKey represents the key for the username of the registry mirrored by a repository
+	RepositoryMirrorCredentialsUsernameKey = "username"
+	// RepositoryMirrorCredentialsPasswordKey represents the key for the password of the registry mirrored by a repository
+	RepositoryMirrorCredentialsPasswordKey = "password"
+	// NotificationURLSecretKey represents the key for the URL receiving the notifications of a repository
+	NotificationURLSecretKey = "url"
+	// QuayUserPasswordSecretKey represents the key for the initial password of a user
+	QuayUserPasswordSecretKey = "password"
 	// InitialQuaySuperuserSecretName represents the name of the secret containing the quay superuser details
 	InitialQuaySuperuserSecretName = "quay-superuser"
 	// InitialQuaySuperuserDefaultUsername represents the default Quay superuser username
@@ -237,6 +275,8 @@ const (
 
 	// QuaySSLCertificate is name of the file containing quay SSL certificate
 	QuaySSLCertificate = "quay.crt"
+	// QuayAppConfigFileSecretKey is key in the app-config secret representing the Quay configuration file
+	QuayAppConfigFileSecretKey = "config.yaml"
 	// QuayAppConfigSSLCertificateSecretKey is key in the app-config secret representing the SSL Certificate
 	QuayAppConfigSSLCertificateSecretKey = "ssl.cert"
 	// QuayConfigVolumeName is the name of the volume containing Quay configurations
@@ -289,6 +329,16 @@ const (
 	ClairMITMCertificate = "/certificates/mitm.crt"
 	// ClairDefaultUpdateInterval is the default interval for Clair to query for CVE updates
 	ClairDefaultUpdateInterval = time.Hour * 6
+	// QuaySetupDatabaseTimeout is the deadline of the request running the migrations of the Quay database during setup
+	QuaySetupDatabaseTimeout = time.Minute * 10
+	// RegistrySmokeTestDefaultInterval is the default duration between runs of the registry smoke test
+	RegistrySmokeTestDefaultInterval = time.Hour
+	// RegistrySmokeTestRetryInterval is the duration before a failed registry smoke test runs again when shorter than its interval
+	RegistrySmokeTestRetryInterval = time.Minute
+	// RegistrySmokeTestTimeout is the deadline of a run of the registry smoke test
+	RegistrySmokeTestTimeout = time.Minute * 2
+	// RegistrySmokeTestPollInterval is the duration between checks for the result of a running registry smoke test
+	RegistrySmokeTestPollInterval = time.Second * 10
 	// DatabaseComponentQuay is the name of the Quay database
 	DatabaseComponentQuay DatabaseComponent = "quay"
 	// DatabaseComponentClair is the name of the Quay database
@@ -355,9 +405,15 @@ var (
 	// RequiredSwiftCredentialKeys represents the keys that are required for the Swift registry backend
 	RequiredSwiftCredentialKeys = []string{SwiftUser, SwiftPassword}
 
+	// RequiredRepositoryMirrorCredentialKeys represents the keys that are required for the credentials of a mirrored registry
+	RequiredRepositoryMirrorCredentialKeys = []string{RepositoryMirrorCredentialsUsernameKey, RepositoryMirrorCredentialsPasswordKey}
+
 	// RequiredCloudfrontS3CredentialKeys represents the keys that are required for the Cloudfront S3 registry backend
 	RequiredCloudfrontS3CredentialKeys = []string{CloudfrontS3AccessKey, CloudfrontS3SecretKey}
 
+	// OrganizationBridgeServiceAccounts are the service accounts of a namespace linked to the pull secret of its organization
+	OrganizationBridgeServiceAccounts = []string{"default", "builder"}
+
 	// QuayEcosystemServiceAccounts is a list of service accounts that are part of the QuayEcosystem
 	QuayEcosystemServiceAccounts = []string{QuayServiceAccount, ClairServiceAccount}
 
@@ -39,6 +39,8 @@ func (r *LoadBalancerExternalAccess) ManageQuayExternalAccess(meta metav1.Object
 		r.QuayConfiguration.QuayHostname = r.QuayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Hostname
 	}
 
+	r.QuayConfiguration.QuayEcosystem.Status.Hostname = r.QuayConfiguration.QuayHostname
+
 	return nil
 
 }
@@ -0,0 +1,97 @@
+package quayecosystem
+
+import (
+	"reflect"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ownedResourceIgnoredFields are the fields of an owned resource that are maintained by the cluster and do not represent drift
+var ownedResourceIgnoredFields = [][]string{
+	{"status"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+}
+
+// QuayEcosystemChangedPredicate fires an update event when the specification or finalizers of a QuayEcosystem change or when the regeneration of its API token is requested
+type QuayEcosystemChangedPredicate struct {
+	util.ResourceGenerationOrFinalizerChangedPredicate
+}
+
+// Update determines whether the specification, finalizers or token regeneration annotation changed
+func (p QuayEcosystemChangedPredicate) Update(e event.UpdateEvent) bool {
+
+	if p.ResourceGenerationOrFinalizerChangedPredicate.Update(e) {
+		return true
+	}
+
+	if e.MetaOld == nil || e.MetaNew == nil {
+		return false
+	}
+
+	return e.MetaOld.GetAnnotations()[constants.RegenerateTokenAnnotationKey] != e.MetaNew.GetAnnotations()[constants.RegenerateTokenAnnotationKey]
+}
+
+// OwnedResourceChangedPredicate fires an update event for resources owned by a QuayEcosystem only when a field other than the status or the server maintained metadata has changed
+// or when the readiness of a Deployment has changed
+type OwnedResourceChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Update filters out update events that do not change the desired state of an owned resource
+func (OwnedResourceChangedPredicate) Update(e event.UpdateEvent) bool {
+
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		logging.Log.Error(nil, "UpdateEvent has no runtime object", "event", e)
+		return false
+	}
+
+	// The readiness of Deployments is reported in the status of the QuayEcosystem
+	if oldDeployment, ok := e.ObjectOld.(*appsv1.Deployment); ok {
+		if newDeployment, ok := e.ObjectNew.(*appsv1.Deployment); ok && deploymentReadinessChanged(oldDeployment, newDeployment) {
+			return true
+		}
+	}
+
+	oldObject, err := desiredState(e.ObjectOld)
+	if err != nil {
+		logging.Log.Error(err, "Failed to convert owned resource", "event", e)
+		return true
+	}
+
+	newObject, err := desiredState(e.ObjectNew)
+	if err != nil {
+		logging.Log.Error(err, "Failed to convert owned resource", "event", e)
+		return true
+	}
+
+	return !reflect.DeepEqual(oldObject, newObject)
+}
+
+// deploymentReadinessChanged determines whether the number of ready or available replicas of a Deployment changed
+func deploymentReadinessChanged(oldDeployment *appsv1.Deployment, newDeployment *appsv1.Deployment) bool {
+	return oldDeployment.Status.ReadyReplicas != newDeployment.Status.ReadyReplicas || oldDeployment.Status.AvailableReplicas != newDeployment.Status.AvailableReplicas
+}
+
+// desiredState returns the content of an object without the fields maintained by the cluster
+func desiredState(obj runtime.Object) (map[string]interface{}, error) {
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range ownedResourceIgnoredFields {
+		unstructured.RemoveNestedField(content, field...)
+	}
+
+	return content, nil
+}
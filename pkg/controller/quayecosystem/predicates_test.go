@@ -0,0 +1,93 @@
+package quayecosystem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestOwnedResourceChangedPredicate(t *testing.T) {
+
+	replicas := int32(1)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: "1",
+			Generation:      1,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+		},
+	}
+
+	statusUpdated := deployment.DeepCopy()
+	statusUpdated.ResourceVersion = "2"
+	statusUpdated.Status.ReadyReplicas = 1
+
+	observedGenerationUpdated := deployment.DeepCopy()
+	observedGenerationUpdated.ResourceVersion = "2"
+	observedGenerationUpdated.Status.ObservedGeneration = 1
+
+	specUpdated := deployment.DeepCopy()
+	specUpdated.ResourceVersion = "2"
+	specUpdated.Generation = 2
+	specUpdated.Spec.Template.Spec.Containers = []corev1.Container{{Name: "quay"}}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: "1",
+		},
+		Data: map[string][]byte{"config.yaml": []byte("SERVER_HOSTNAME: quay.example.com")},
+	}
+
+	secretUpdated := secret.DeepCopy()
+	secretUpdated.ResourceVersion = "2"
+	secretUpdated.Data = nil
+
+	cases := []struct {
+		name     string
+		event    event.UpdateEvent
+		expected bool
+	}{
+		{
+			name:     "deploymentReadinessChanged",
+			event:    event.UpdateEvent{MetaOld: deployment, ObjectOld: deployment, MetaNew: statusUpdated, ObjectNew: statusUpdated},
+			expected: true,
+		},
+		{
+			name:     "deploymentObservedGenerationChanged",
+			event:    event.UpdateEvent{MetaOld: deployment, ObjectOld: deployment, MetaNew: observedGenerationUpdated, ObjectNew: observedGenerationUpdated},
+			expected: false,
+		},
+		{
+			name:     "deploymentSpecChanged",
+			event:    event.UpdateEvent{MetaOld: deployment, ObjectOld: deployment, MetaNew: specUpdated, ObjectNew: specUpdated},
+			expected: true,
+		},
+		{
+			name:     "secretDataChanged",
+			event:    event.UpdateEvent{MetaOld: secret, ObjectOld: secret, MetaNew: secretUpdated, ObjectNew: secretUpdated},
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, OwnedResourceChangedPredicate{}.Update(c.event))
+		})
+	}
+}
+
+func TestOwnedResources(t *testing.T) {
+
+	assert.Len(t, ownedResources(false, false), 5)
+	assert.Len(t, ownedResources(true, true), 7)
+}
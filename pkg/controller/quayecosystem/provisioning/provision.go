@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -263,17 +265,17 @@ func (r *ReconcileQuayEcosystemConfiguration) ConfigureSecurityScanner(meta meta
 		return nil, err
 	}
 
-	httpClient := resources.GetDefaultHTTPClient()
+	httpClient := resources.GetDefaultHTTPClient(resources.GetQuayConfigAppTLSConfig(r.quayConfiguration))
 
 	quayConfigURL := fmt.Sprintf("https://%s", r.quayConfiguration.QuayConfigHostname)
 
 	configClient := qclient.NewClient(httpClient, quayConfigURL, r.quayConfiguration.QuayConfigUsername, r.quayConfiguration.QuayConfigPassword)
 
-	_, configuredKeys, err := configClient.GetKeys()
+	_, configuredKeys, err := configClient.GetKeys(context.TODO())
 
 	if err != nil {
 		logging.Log.Error(err, "Error obtaining service keys")
-		return nil, err
+		return nil, resources.WrapTLSVerificationError(err)
 	}
 
 	// Check if a valid service key is present
@@ -286,7 +288,7 @@ func (r *ReconcileQuayEcosystemConfiguration) ConfigureSecurityScanner(meta meta
 
 	// Create a new service key and secret
 	if !serviceKeyFound {
-		_, keyCreationResponse, err := configClient.CreateKey(qclient.KeyCreationRequest{
+		_, keyCreationResponse, err := configClient.CreateKey(context.TODO(), qclient.KeyCreationRequest{
 			Name:    constants.SecurityScannerService,
 			Service: constants.SecurityScannerService,
 			Notes:   resources.GetSecurityScannerKeyNotes(r.quayConfiguration.QuayEcosystem),
@@ -685,8 +687,15 @@ func (r *ReconcileQuayEcosystemConfiguration) manageClairConfigMap(meta metav1.O
 	if utils.IsZeroOfUnderlyingType(r.quayConfiguration.QuayEcosystem.Spec.Clair.Database.ConnectionParameters) {
 		dbConnectionParams = "sslmode=disable"
 	} else {
-		for dbConnKey, dbConnValue := range r.quayConfiguration.QuayEcosystem.Spec.Clair.Database.ConnectionParameters {
-			dbConnectionParams = dbConnectionParams + "&" + dbConnKey + "=" + dbConnValue
+		// Parameters are sorted so that the rendered configuration does not change between reconciliations
+		dbConnKeys := []string{}
+		for dbConnKey := range r.quayConfiguration.QuayEcosystem.Spec.Clair.Database.ConnectionParameters {
+			dbConnKeys = append(dbConnKeys, dbConnKey)
+		}
+		sort.Strings(dbConnKeys)
+
+		for _, dbConnKey := range dbConnKeys {
+			dbConnectionParams = dbConnectionParams + "&" + dbConnKey + "=" + r.quayConfiguration.QuayEcosystem.Spec.Clair.Database.ConnectionParameters[dbConnKey]
 		}
 	}
 
@@ -791,21 +800,13 @@ func (r *ReconcileQuayEcosystemConfiguration) ManageQuayEcosystemCertificates(me
 		return nil, err
 	}
 
+	// Existing certificates are left untouched so clients trusting them keep working
 	if !isQuayCertificatesConfigured(appConfigSecret) {
 
 		if utils.IsZeroOfUnderlyingType(r.quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.TLS.SecretName) {
-			var certBytes, privKeyBytes []byte
-
-			// Check if hostname is an IP address or hostname
-			hostnameParts := strings.Split(r.quayConfiguration.QuayHostname, ":")
-
-			parsedIP := net.ParseIP(hostnameParts[0])
 
-			if parsedIP == nil {
-				certBytes, privKeyBytes, err = cert.GenerateSelfSignedCertKey(constants.QuayEnterprise, []net.IP{}, []string{hostnameParts[0]})
-			} else {
-				certBytes, privKeyBytes, err = cert.GenerateSelfSignedCertKey(constants.QuayEnterprise, []net.IP{parsedIP}, []string{})
-			}
+			// The config app serves the certificate of Quay so the generated certificate is valid for both hostnames
+			certBytes, privKeyBytes, err := generateQuayCertificate([]string{r.quayConfiguration.QuayHostname, r.quayConfiguration.QuayConfigHostname})
 
 			if err != nil {
 				logging.Log.Error(err, "Error creating public/private key")
@@ -818,7 +819,7 @@ func (r *ReconcileQuayEcosystemConfiguration) ManageQuayEcosystemCertificates(me
 			meta.Name = r.quayConfiguration.QuayTLSSecretName
 
 			quaySslSecret := &corev1.Secret{}
-			err := r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Name: r.quayConfiguration.QuayTLSSecretName, Namespace: r.quayConfiguration.QuayEcosystem.ObjectMeta.Namespace}, quaySslSecret)
+			err = r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Name: r.quayConfiguration.QuayTLSSecretName, Namespace: r.quayConfiguration.QuayEcosystem.ObjectMeta.Namespace}, quaySslSecret)
 
 			if err != nil && !apierrors.IsNotFound(err) {
 				logging.Log.Error(err, "Error Finding Quay SSL Secret", "Namespace", r.quayConfiguration.QuayEcosystem.Namespace, "Name", r.quayConfiguration.QuayTLSSecretName)
@@ -956,7 +957,14 @@ func (r *ReconcileQuayEcosystemConfiguration) SyncQuayConfigSecret(meta metav1.O
 
 	appConfigSecret, changed := copyConfigFileExtraCaCertToConfigSecret(r.quayConfiguration.QuayConfigFiles, appConfigSecret)
 
-	if changed {
+	appConfigSecret, credentialsChanged, err := syncRegistryBackendCredentials(r.quayConfiguration.RegistryBackends, appConfigSecret)
+
+	if err != nil {
+		logging.Log.Error(err, "Error Synchronizing Registry Backend Credentials", "Namespace", appConfigSecret.Namespace, "Name", appConfigSecret.Name)
+		return nil, err
+	}
+
+	if changed || credentialsChanged {
 		err = r.reconcilerBase.CreateOrUpdateResource(r.quayConfiguration.QuayEcosystem, r.quayConfiguration.QuayEcosystem.Namespace, appConfigSecret)
 
 		if err != nil {
@@ -971,15 +979,13 @@ func (r *ReconcileQuayEcosystemConfiguration) SyncQuayConfigSecret(meta metav1.O
 
 func (r *ReconcileQuayEcosystemConfiguration) quayDeployment(meta metav1.ObjectMeta) error {
 
-	quayDeployment := resources.GetQuayDeploymentDefinition(meta, r.quayConfiguration)
-
-	err := r.reconcilerBase.CreateOrUpdateResource(r.quayConfiguration.QuayEcosystem, r.quayConfiguration.QuayEcosystem.Namespace, quayDeployment)
-
-	if err != nil {
+	if err := r.manageQuayConfigChecksum(); err != nil {
 		return err
 	}
 
-	return nil
+	quayDeployment := resources.GetQuayDeploymentDefinition(meta, r.quayConfiguration)
+
+	return r.manageScaledDeployment(quayDeployment, r.quayConfiguration.QuayEcosystem.Spec.Quay.HorizontalPodAutoscaler)
 
 }
 
@@ -1010,30 +1016,131 @@ func (r *ReconcileQuayEcosystemConfiguration) quayConfigDeployment(meta metav1.O
 
 func (r *ReconcileQuayEcosystemConfiguration) quayRepoMirrorDeployment(meta metav1.ObjectMeta) error {
 
+	if err := r.manageQuayConfigChecksum(); err != nil {
+		return err
+	}
+
 	quayDeployment := resources.GetQuayRepoMirrorDeploymentDefinition(meta, r.quayConfiguration)
 
-	err := r.reconcilerBase.CreateOrUpdateResource(r.quayConfiguration.QuayEcosystem, r.quayConfiguration.QuayEcosystem.Namespace, quayDeployment)
+	return r.manageScaledDeployment(quayDeployment, r.quayConfiguration.QuayEcosystem.Spec.Quay.RepoMirrorHorizontalPodAutoscaler)
 
-	if err != nil {
+}
+
+func (r *ReconcileQuayEcosystemConfiguration) clairDeployment(meta metav1.ObjectMeta) error {
+
+	if err := r.manageClairConfigChecksum(); err != nil {
 		return err
 	}
 
+	clairDeployment := resources.GetClairDeploymentDefinition(meta, r.quayConfiguration)
+
+	return r.manageScaledDeployment(clairDeployment, r.quayConfiguration.QuayEcosystem.Spec.Clair.HorizontalPodAutoscaler)
+
+}
+
+// manageQuayConfigChecksum computes the checksum of the rendered Quay configuration Secret and the configuration files mounted by Quay
+func (r *ReconcileQuayEcosystemConfiguration) manageQuayConfigChecksum() error {
+
+	content := map[string][]byte{}
+
+	configSecretName := resources.GetQuaySecretName(r.quayConfiguration.QuayEcosystem)
+
+	appConfigSecret := &corev1.Secret{}
+	err := r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Name: configSecretName, Namespace: r.quayConfiguration.QuayEcosystem.Namespace}, appConfigSecret)
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		logging.Log.Error(err, "Error Finding Quay Config Secret", "Namespace", r.quayConfiguration.QuayEcosystem.Namespace, "Name", configSecretName)
+		return err
+	}
+
+	for key, value := range appConfigSecret.Data {
+		content[fmt.Sprintf("%s/%s", configSecretName, key)] = value
+	}
+
+	addConfigFilesContent(content, r.quayConfiguration.QuayConfigFiles)
+
+	r.quayConfiguration.QuayConfigChecksum = utils.Checksum(content)
+
 	return nil
+}
+
+// manageClairConfigChecksum computes the checksum of the Clair configuration ConfigMap and the configuration files mounted by Clair
+func (r *ReconcileQuayEcosystemConfiguration) manageClairConfigChecksum() error {
+
+	content := map[string][]byte{}
+
+	clairConfigMapName := resources.GetClairConfigMapName(r.quayConfiguration.QuayEcosystem)
+
+	clairConfigMap := &corev1.ConfigMap{}
+	err := r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Name: clairConfigMapName, Namespace: r.quayConfiguration.QuayEcosystem.Namespace}, clairConfigMap)
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		logging.Log.Error(err, "Error Finding Clair ConfigMap", "Namespace", r.quayConfiguration.QuayEcosystem.Namespace, "Name", clairConfigMapName)
+		return err
+	}
 
+	for key, value := range clairConfigMap.Data {
+		content[fmt.Sprintf("%s/%s", clairConfigMapName, key)] = []byte(value)
+	}
+
+	addConfigFilesContent(content, r.quayConfiguration.ClairConfigFiles)
+
+	r.quayConfiguration.ClairConfigChecksum = utils.Checksum(content)
+
+	return nil
 }
 
-func (r *ReconcileQuayEcosystemConfiguration) clairDeployment(meta metav1.ObjectMeta) error {
+// manageScaledDeployment creates or updates a Deployment along with its optional HorizontalPodAutoscaler
+// The number of replicas of an autoscaled Deployment is left to the HorizontalPodAutoscaler
+func (r *ReconcileQuayEcosystemConfiguration) manageScaledDeployment(deployment *appsv1.Deployment, horizontalPodAutoscaler *redhatcopv1alpha1.HorizontalPodAutoscaler) error {
 
-	clairDeployment := resources.GetClairDeploymentDefinition(meta, r.quayConfiguration)
+	if horizontalPodAutoscaler != nil {
 
-	err := r.reconcilerBase.CreateOrUpdateResource(r.quayConfiguration.QuayEcosystem, r.quayConfiguration.QuayEcosystem.Namespace, clairDeployment)
+		existingDeployment := &appsv1.Deployment{}
+		err := r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Name: deployment.Name, Namespace: deployment.Namespace}, existingDeployment)
+
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if err == nil {
+			deployment.Spec.Replicas = existingDeployment.Spec.Replicas
+		} else if horizontalPodAutoscaler.MinReplicas != nil {
+			deployment.Spec.Replicas = horizontalPodAutoscaler.MinReplicas
+		}
+	}
+
+	err := r.reconcilerBase.CreateOrUpdateResource(r.quayConfiguration.QuayEcosystem, r.quayConfiguration.QuayEcosystem.Namespace, deployment)
 
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if horizontalPodAutoscaler == nil {
+
+		// Only a HorizontalPodAutoscaler remaining from a previous configuration is deleted
+		existingHorizontalPodAutoscaler := &autoscalingv1.HorizontalPodAutoscaler{}
+		err = r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Name: deployment.Name, Namespace: deployment.Namespace}, existingHorizontalPodAutoscaler)
+
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = r.reconcilerBase.GetClient().Delete(context.TODO(), existingHorizontalPodAutoscaler)
+
+		if err != nil && !apierrors.IsNotFound(err) {
+			logging.Log.Error(err, "Error Deleting HorizontalPodAutoscaler", "Namespace", deployment.Namespace, "Name", deployment.Name)
+			return err
+		}
 
+		return nil
+	}
+
+	return r.reconcilerBase.CreateOrUpdateResource(r.quayConfiguration.QuayEcosystem, r.quayConfiguration.QuayEcosystem.Namespace, resources.GetHorizontalPodAutoscalerDefinition(deployment, horizontalPodAutoscaler))
 }
 
 func (r *ReconcileQuayEcosystemConfiguration) createRedisService(meta metav1.ObjectMeta) error {
@@ -1104,6 +1211,30 @@ func (r *ReconcileQuayEcosystemConfiguration) manageService(serviceName string,
 
 }
 
+// generateQuayCertificate returns a self signed certificate and its private key valid for the provided hostnames
+func generateQuayCertificate(hostnames []string) ([]byte, []byte, error) {
+
+	ips := []net.IP{}
+	dnsNames := []string{}
+
+	for _, hostname := range hostnames {
+
+		host := utils.GetHostFromHostname(hostname)
+
+		if host == "" {
+			continue
+		}
+
+		if ip := net.ParseIP(host); ip != nil {
+			ips = append(ips, ip)
+		} else {
+			dnsNames = append(dnsNames, host)
+		}
+	}
+
+	return cert.GenerateSelfSignedCertKey(constants.QuayEnterprise, ips, dnsNames)
+}
+
 func isQuayCertificatesConfigured(secret *corev1.Secret) bool {
 
 	if !utils.IsZeroOfUnderlyingType(secret) {
@@ -1185,6 +1316,106 @@ func copyConfigFileExtraCaCertToConfigSecret(configFiles []redhatcopv1alpha1.Con
 	return secret, changed
 }
 
+// syncRegistryBackendCredentials updates the credentials of the registry backends in the Quay configuration file so that changes to their Secrets reach Quay
+// Only the credentials are updated as the remainder of the storage configuration cannot change once Quay has been set up
+func syncRegistryBackendCredentials(registryBackends []redhatcopv1alpha1.RegistryBackend, secret *corev1.Secret) (*corev1.Secret, bool, error) {
+
+	configFile, ok := secret.Data[constants.QuayAppConfigFileSecretKey]
+
+	if !ok {
+		return secret, false, nil
+	}
+
+	// The order of the configuration is retained so that the file is only rewritten by actual changes
+	config := yaml.MapSlice{}
+
+	if err := yaml.Unmarshal(configFile, &config); err != nil {
+		return secret, false, err
+	}
+
+	storageConfig, ok := getMapSliceValue(config, "DISTRIBUTED_STORAGE_CONFIG").(yaml.MapSlice)
+
+	if !ok {
+		return secret, false, nil
+	}
+
+	changed := false
+
+	for _, registryBackend := range registryBackends {
+
+		// Each location consists of the name of the storage driver and its parameters
+		location, ok := getMapSliceValue(storageConfig, registryBackend.Name).([]interface{})
+
+		if !ok || len(location) != 2 {
+			continue
+		}
+
+		parameters, ok := location[1].(yaml.MapSlice)
+
+		if !ok {
+			continue
+		}
+
+		for key, value := range resources.GetRegistryBackendCredentials(registryBackend) {
+			if existingValue, _ := getMapSliceValue(parameters, key).(string); existingValue != value {
+				parameters = setMapSliceValue(parameters, key, value)
+				changed = true
+			}
+		}
+
+		location[1] = parameters
+	}
+
+	if !changed {
+		return secret, false, nil
+	}
+
+	configFile, err := yaml.Marshal(config)
+
+	if err != nil {
+		return secret, false, err
+	}
+
+	secret.Data[constants.QuayAppConfigFileSecretKey] = configFile
+
+	return secret, true, nil
+}
+
+// getMapSliceValue returns the value of a key in a MapSlice or nil when the key is not present
+func getMapSliceValue(mapSlice yaml.MapSlice, key string) interface{} {
+
+	for _, item := range mapSlice {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+
+	return nil
+}
+
+// setMapSliceValue sets the value of a key in a MapSlice, appending the key when it is not present
+func setMapSliceValue(mapSlice yaml.MapSlice, key string, value interface{}) yaml.MapSlice {
+
+	for i, item := range mapSlice {
+		if item.Key == key {
+			mapSlice[i].Value = value
+			return mapSlice
+		}
+	}
+
+	return append(mapSlice, yaml.MapItem{Key: key, Value: value})
+}
+
+// addConfigFilesContent adds the content of each configuration file to the content used to compute a checksum
+func addConfigFilesContent(content map[string][]byte, configFiles []redhatcopv1alpha1.ConfigFiles) {
+
+	for _, configFile := range configFiles {
+		for _, file := range configFile.Files {
+			content[fmt.Sprintf("%s/%s/%s", configFile.SecretName, file.Type, file.Key)] = file.SecretContent
+		}
+	}
+}
+
 func diff(lhsSlice, rhsSlice []string) (lhsOnly []string, rhsOnly []string) {
 	return singleDiff(lhsSlice, rhsSlice), singleDiff(rhsSlice, lhsSlice)
 }
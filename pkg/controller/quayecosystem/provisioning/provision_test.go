@@ -1,14 +1,28 @@
 package provisioning
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"reflect"
 
+	"github.com/redhat-cop/operator-utils/pkg/util"
 	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/cert"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestQuayCertificatesConfigured(t *testing.T) {
@@ -49,6 +63,110 @@ func TestQuayCertificatesConfigured(t *testing.T) {
 	}
 }
 
+// verifyHostname verifies that the PEM encoded certificate is valid for the hostname
+func verifyHostname(t *testing.T, certificate []byte, hostname string) error {
+
+	certificates, err := cert.ParseCertsPEM(certificate)
+	assert.NoError(t, err)
+
+	return certificates[0].VerifyHostname(hostname)
+}
+
+func TestGenerateQuayCertificate(t *testing.T) {
+
+	certificate, privateKey, err := generateQuayCertificate([]string{"quay.example.com", "quay-config.example.com:8443", "10.0.0.1:30443", ""})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, privateKey)
+	assert.NoError(t, verifyHostname(t, certificate, "quay.example.com"))
+	assert.NoError(t, verifyHostname(t, certificate, "quay-config.example.com"))
+	assert.NoError(t, verifyHostname(t, certificate, "10.0.0.1"))
+	assert.Error(t, verifyHostname(t, certificate, "registry.example.com"))
+}
+
+func TestManageQuayEcosystemCertificates(t *testing.T) {
+
+	existingCertificate, existingPrivateKey, err := generateQuayCertificate([]string{"quay.example.com"})
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name         string
+		configSecret *corev1.Secret
+		certificate  []byte
+	}{
+		{
+			name: "GeneratedCertificate",
+			configSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "quay-enterprise-config-secret", Namespace: "quay-enterprise"},
+			},
+		},
+		{
+			// A certificate not covering the config hostname is kept as clients may already trust it
+			name: "ExistingCertificate",
+			configSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "quay-enterprise-config-secret", Namespace: "quay-enterprise"},
+				Data: map[string][]byte{
+					constants.QuayAppConfigSSLCertificateSecretKey: existingCertificate,
+					constants.QuayAppConfigSSLPrivateKeySecretKey:  existingPrivateKey,
+				},
+			},
+			certificate: existingCertificate,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "quay-ecosystem",
+					Namespace: "quay-enterprise",
+				},
+				Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+					Quay: &redhatcopv1alpha1.Quay{
+						ExternalAccess: &redhatcopv1alpha1.ExternalAccess{
+							TLS: &redhatcopv1alpha1.TLSExternalAccess{
+								Termination: redhatcopv1alpha1.PassthroughTLSTerminationType,
+							},
+						},
+					},
+				},
+			}
+
+			s := scheme.Scheme
+			s.AddKnownTypes(redhatcopv1alpha1.SchemeGroupVersion, quayEcosystem)
+
+			cl := fake.NewFakeClientWithScheme(s, []runtime.Object{quayEcosystem, c.configSecret}...)
+			r := New(util.NewReconcilerBase(cl, s, nil, nil), nil, &resources.QuayConfiguration{
+				QuayEcosystem:      quayEcosystem,
+				QuayHostname:       "quay.example.com",
+				QuayConfigHostname: "quay-config.example.com",
+				QuayTLSSecretName:  "quay-ecosystem-quay-ssl",
+			})
+
+			_, err := r.ManageQuayEcosystemCertificates(quayEcosystem.ObjectMeta)
+			assert.NoError(t, err)
+
+			configSecret := &corev1.Secret{}
+			assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: c.configSecret.Name, Namespace: c.configSecret.Namespace}, configSecret))
+
+			certificate := configSecret.Data[constants.QuayAppConfigSSLCertificateSecretKey]
+
+			if c.certificate != nil {
+				assert.Equal(t, c.certificate, certificate)
+				return
+			}
+
+			assert.NoError(t, verifyHostname(t, certificate, "quay.example.com"))
+			assert.NoError(t, verifyHostname(t, certificate, "quay-config.example.com"))
+
+			tlsSecret := &corev1.Secret{}
+			assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "quay-ecosystem-quay-ssl", Namespace: "quay-enterprise"}, tlsSecret))
+			assert.Equal(t, certificate, tlsSecret.Data[corev1.TLSCertKey])
+		})
+	}
+}
+
 func TestCopySecretContent(t *testing.T) {
 
 	cases := []struct {
@@ -179,3 +297,182 @@ func TestConfigFileExtraCaCertToConfigSecret(t *testing.T) {
 		}
 	}
 }
+
+func TestSyncRegistryBackendCredentials(t *testing.T) {
+
+	configFile := `DISTRIBUTED_STORAGE_CONFIG:
+  default:
+  - S3Storage
+  - host: s3.example.com
+    s3_access_key: previous
+    s3_bucket: quay
+    s3_secret_key: secret
+    storage_path: /registry
+  local:
+  - LocalStorage
+  - storage_path: /datastorage/registry
+SERVER_HOSTNAME: quay.example.com
+`
+
+	registryBackends := []redhatcopv1alpha1.RegistryBackend{
+		{
+			Name: "default",
+			RegistryBackendSource: redhatcopv1alpha1.RegistryBackendSource{
+				S3: &redhatcopv1alpha1.S3RegistryBackendSource{
+					StoragePath: "/registry",
+					BucketName:  "quay",
+					Host:        "s3.example.com",
+					AccessKey:   "rotated",
+					SecretKey:   "secret",
+				},
+			},
+		},
+		{
+			Name: "local",
+			RegistryBackendSource: redhatcopv1alpha1.RegistryBackendSource{
+				Local: &redhatcopv1alpha1.LocalRegistryBackendSource{
+					StoragePath: "/datastorage/registry",
+				},
+			},
+		},
+	}
+
+	secret := &corev1.Secret{Data: map[string][]byte{constants.QuayAppConfigFileSecretKey: []byte(configFile)}}
+
+	secret, changed, err := syncRegistryBackendCredentials(registryBackends, secret)
+
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, strings.Replace(configFile, "previous", "rotated", 1), string(secret.Data[constants.QuayAppConfigFileSecretKey]))
+
+	// The configuration file is not rewritten once the credentials are synchronized
+	secret, changed, err = syncRegistryBackendCredentials(registryBackends, secret)
+
+	assert.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestManageScaledDeployment(t *testing.T) {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quay-ecosystem",
+			Namespace: "quay-enterprise",
+		},
+	}
+
+	scaledReplicas := int32(5)
+	desiredReplicas := int32(1)
+
+	existingDeployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quay-ecosystem-quay",
+			Namespace: "quay-enterprise",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &scaledReplicas,
+		},
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(redhatcopv1alpha1.SchemeGroupVersion, quayEcosystem)
+
+	cl := fake.NewFakeClientWithScheme(s, []runtime.Object{quayEcosystem, existingDeployment}...)
+	r := New(util.NewReconcilerBase(cl, s, nil, nil), nil, &resources.QuayConfiguration{QuayEcosystem: quayEcosystem})
+
+	newDeployment := func() *appsv1.Deployment {
+		deployment := existingDeployment.DeepCopy()
+		deployment.ResourceVersion = ""
+		deployment.Spec.Replicas = &desiredReplicas
+		return deployment
+	}
+
+	// An autoscaled Deployment retains the number of replicas set by the HorizontalPodAutoscaler
+	err := r.manageScaledDeployment(newDeployment(), &redhatcopv1alpha1.HorizontalPodAutoscaler{MaxReplicas: 10})
+	assert.NoError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: existingDeployment.Name, Namespace: existingDeployment.Namespace}, deployment))
+	assert.Equal(t, scaledReplicas, *deployment.Spec.Replicas)
+
+	hpa := &autoscalingv1.HorizontalPodAutoscaler{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: existingDeployment.Name, Namespace: existingDeployment.Namespace}, hpa))
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+	assert.Equal(t, existingDeployment.Name, hpa.Spec.ScaleTargetRef.Name)
+
+	// Removing the HorizontalPodAutoscaler returns control of the replicas to the operator
+	err = r.manageScaledDeployment(newDeployment(), nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: existingDeployment.Name, Namespace: existingDeployment.Namespace}, deployment))
+	assert.Equal(t, desiredReplicas, *deployment.Spec.Replicas)
+
+	err = cl.Get(context.TODO(), types.NamespacedName{Name: existingDeployment.Name, Namespace: existingDeployment.Namespace}, hpa)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestManageQuayConfigChecksum(t *testing.T) {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quay-ecosystem",
+			Namespace: "quay-enterprise",
+		},
+		Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+			Quay: &redhatcopv1alpha1.Quay{},
+		},
+	}
+
+	configSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.GetQuaySecretName(quayEcosystem),
+			Namespace: "quay-enterprise",
+		},
+		Data: map[string][]byte{
+			"config.yaml": []byte("SERVER_HOSTNAME: quay.example.com"),
+		},
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(redhatcopv1alpha1.SchemeGroupVersion, quayEcosystem)
+
+	cl := fake.NewFakeClientWithScheme(s, []runtime.Object{quayEcosystem, configSecret}...)
+	quayConfiguration := &resources.QuayConfiguration{QuayEcosystem: quayEcosystem}
+	r := New(util.NewReconcilerBase(cl, s, nil, nil), nil, quayConfiguration)
+
+	assert.NoError(t, r.manageQuayConfigChecksum())
+	checksum := quayConfiguration.QuayConfigChecksum
+	assert.NotEmpty(t, checksum)
+
+	deployment := resources.GetQuayDeploymentDefinition(metav1.ObjectMeta{Labels: map[string]string{}}, quayConfiguration)
+	assert.Equal(t, checksum, deployment.Spec.Template.Annotations[constants.ConfigChecksumAnnotationKey])
+
+	// Recomputing without changes results in the same checksum
+	assert.NoError(t, r.manageQuayConfigChecksum())
+	assert.Equal(t, checksum, quayConfiguration.QuayConfigChecksum)
+
+	// Extra CA certificates change the checksum
+	quayConfiguration.QuayConfigFiles = []redhatcopv1alpha1.ConfigFiles{
+		{
+			SecretName: "quay-config-files",
+			Files: []redhatcopv1alpha1.ConfigFile{
+				{Key: "ca.crt", Type: redhatcopv1alpha1.ExtraCaCertConfigFileType, SecretContent: []byte("certificate")},
+			},
+		},
+	}
+
+	assert.NoError(t, r.manageQuayConfigChecksum())
+	assert.NotEqual(t, checksum, quayConfiguration.QuayConfigChecksum)
+	checksum = quayConfiguration.QuayConfigChecksum
+
+	// Changes to the rendered configuration change the checksum
+	configSecret.Data["config.yaml"] = []byte("SERVER_HOSTNAME: registry.example.com")
+	assert.NoError(t, cl.Update(context.TODO(), configSecret))
+
+	assert.NoError(t, r.manageQuayConfigChecksum())
+	assert.NotEqual(t, checksum, quayConfiguration.QuayConfigChecksum)
+}
@@ -9,6 +9,7 @@ import (
 	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
 	"gopkg.in/yaml.v3"
 
+	routev1 "github.com/openshift/api/route/v1"
 	"github.com/redhat-cop/operator-utils/pkg/util"
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/externalaccess"
@@ -21,9 +22,13 @@ import (
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/utils"
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/validation"
 	"github.com/redhat-cop/quay-operator/pkg/k8sutils"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
 	"k8s.io/client-go/kubernetes"
@@ -44,34 +49,49 @@ func Add(mgr manager.Manager) error {
 		return err
 	}
 
-	return add(mgr, newReconciler(mgr, k8sclient))
+	reconciler := newReconciler(mgr, k8sclient)
+
+	hasIngress := k8sutils.HasAPIResource(k8sclient.Discovery(), networkingv1beta1.SchemeGroupVersion.String(), "ingresses")
+
+	return add(mgr, reconciler, ownedResources(reconciler.isOpenShift, hasIngress))
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, k8sclient kubernetes.Interface) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, k8sclient kubernetes.Interface) *ReconcileQuayEcosystem {
 
 	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quayecosystem-controller"))
 
 	discoveryClient, _ := reconcilerBase.GetDiscoveryClient()
 
-	// Query for known OpenShift API resource to verify it is available
-	_, resourcesErr := discoveryClient.ServerResourcesForGroupVersion("security.openshift.io/v1")
+	isOpenShift := k8sutils.IsOpenShift(discoveryClient)
 
-	isOpenShift := true
+	return &ReconcileQuayEcosystem{reconcilerBase: reconcilerBase, k8sclient: k8sclient, quaySetupManager: setup.NewQuaySetupManager(reconcilerBase, k8sclient), isOpenShift: isOpenShift}
+}
 
-	if resourcesErr != nil {
-		if errors.IsNotFound(resourcesErr) {
-			isOpenShift = false
-		} else {
-			logging.Log.Error(resourcesErr, "Error Determining Whether Quay Operator Running in OpenShift")
-		}
+// ownedResources returns the types of resources created by the operator that are watched to repair drift
+func ownedResources(isOpenShift bool, hasIngress bool) []runtime.Object {
+
+	owned := []runtime.Object{
+		&appsv1.Deployment{},
+		&corev1.Service{},
+		&corev1.Secret{},
+		&corev1.PersistentVolumeClaim{},
+		&autoscalingv1.HorizontalPodAutoscaler{},
 	}
 
-	return &ReconcileQuayEcosystem{reconcilerBase: reconcilerBase, k8sclient: k8sclient, quaySetupManager: setup.NewQuaySetupManager(reconcilerBase, k8sclient), isOpenShift: isOpenShift}
+	if isOpenShift {
+		owned = append(owned, &routev1.Route{})
+	}
+
+	if hasIngress {
+		owned = append(owned, &networkingv1beta1.Ingress{})
+	}
+
+	return owned
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
-func add(mgr manager.Manager, r reconcile.Reconciler) error {
+func add(mgr manager.Manager, r reconcile.Reconciler, owned []runtime.Object) error {
 	// Create a new controller
 	c, err := controller.New("quayecosystem-controller", mgr, controller.Options{Reconciler: r})
 	if err != nil {
@@ -79,11 +99,35 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	}
 
 	// Watch for changes to primary resource QuayEcosystem
-	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayEcosystem{}}, &handler.EnqueueRequestForObject{}, util.ResourceGenerationOrFinalizerChangedPredicate{})
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayEcosystem{}}, &handler.EnqueueRequestForObject{}, QuayEcosystemChangedPredicate{})
 	if err != nil {
 		return err
 	}
 
+	// Watch for changes to the Secrets referenced in the specification of a QuayEcosystem
+	err = mgr.GetFieldIndexer().IndexField(&redhatcopv1alpha1.QuayEcosystem{}, referencedSecretsIndexField, indexReferencedSecrets)
+	if err != nil {
+		return err
+	}
+
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: &referencedSecretMapper{client: mgr.GetClient()},
+	}, OwnedResourceChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to secondary resources so that drift from the desired state is repaired
+	for _, ownedType := range owned {
+		err = c.Watch(&source.Kind{Type: ownedType}, &handler.EnqueueRequestForOwner{
+			IsController: true,
+			OwnerType:    &redhatcopv1alpha1.QuayEcosystem{},
+		}, OwnedResourceChangedPredicate{})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -114,30 +158,41 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 	}
 
 	// Initialize a new Quay Configuration Resource
+	// Defaults are applied to a copy so that the stored specification remains as the user provided it
 	quayConfiguration := resources.QuayConfiguration{
-		QuayEcosystem:              quayEcosystem,
+		QuayEcosystem:              quayEcosystem.DeepCopy(),
 		IsOpenShift:                r.isOpenShift,
 		RequiredSCCServiceAccounts: []string{constants.QuayServiceAccount},
 	}
 
+	// Conditions from previous versions of the operator are replaced by the standard condition set
+	quayConfiguration.QuayEcosystem.RemoveUnknownConditions()
+
 	// Initialize Configuration
 	configuration := provisioning.New(r.reconcilerBase, r.k8sclient, &quayConfiguration)
 	metaObject := resources.NewResourceObjectMeta(quayConfiguration.QuayEcosystem)
 
 	// Set default values
-	changed := validation.SetDefaults(r.reconcilerBase.GetClient(), &quayConfiguration)
+	validation.SetDefaults(r.reconcilerBase.GetClient(), &quayConfiguration)
 
-	if changed {
-
-		err := r.reconcilerBase.GetClient().Update(context.TODO(), quayConfiguration.QuayEcosystem)
+	// Validate Configuration
+	valid, err := validation.Validate(r.reconcilerBase.GetClient(), &quayConfiguration)
+	if err != nil {
+		return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemValidationFailure, err)
+	}
+	if !valid {
+		return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemValidationFailure, err)
+	}
 
-		if err != nil {
-			logging.Log.Error(err, "Failed to update QuayEcosystem after setting defaults")
-			return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemProvisioningFailure, err)
+	// Publish the effective specification once validation has completed it
+	effectiveSpecChanged, err := quayConfiguration.QuayEcosystem.SetEffectiveSpec(&quayConfiguration.QuayEcosystem.Spec)
+	if err != nil {
+		return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemUpdateDefaultConfigurationConditionFailure, err)
+	}
 
-		}
+	if effectiveSpecChanged {
 
-		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemUpdateDefaultConfigurationConditionSuccess, "", "Configuration Updated Successfully")
+		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemUpdateDefaultConfigurationConditionSuccess, "Configuration Updated Successfully")
 
 		if err != nil {
 			logging.Log.Error(err, "Failed to update QuayEcosystem status after setting defaults")
@@ -146,15 +201,6 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 
 	}
 
-	// Validate Configuration
-	valid, err := validation.Validate(r.reconcilerBase.GetClient(), &quayConfiguration)
-	if err != nil {
-		return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemValidationFailure, err)
-	}
-	if !valid {
-		return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemValidationFailure, err)
-	}
-
 	// Instantiate External Access
 	var external externalaccess.ExternalAccess
 
@@ -230,9 +276,6 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 	// Execute setup if it has not been completed
 	if !quayConfiguration.QuayEcosystem.Status.SetupComplete && !quayConfiguration.QuayEcosystem.Spec.Quay.SkipSetup {
 
-		// Wait 5 seconds prior to kicking off setup
-		time.Sleep(time.Duration(5) * time.Second)
-
 		quaySetupInstance, err := r.quaySetupManager.NewQuaySetupInstance(&quayConfiguration)
 
 		if err != nil {
@@ -250,7 +293,7 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 		// Update flags when setup is completed
 		quayConfiguration.QuayEcosystem.Status.SetupComplete = true
 
-		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemQuaySetupSuccess, "", "Setup Completed Successfully")
+		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemQuaySetupSuccess, "Setup Completed Successfully")
 		if err != nil {
 			logging.Log.Error(err, "Failed to update QuayEcosystem status after Quay Setup Completion")
 			return reconcile.Result{}, err
@@ -307,7 +350,7 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 			return *configureSecurityScannerResult, nil
 		}
 
-		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemClairConfigurationSuccess, "", "Clair Configuration Updated Successfully")
+		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemClairConfigurationSuccess, "Clair Configuration Updated Successfully")
 
 		if err != nil {
 			logging.Log.Error(err, "Failed to update QuayEcosystem after security scanner completion")
@@ -326,7 +369,7 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 			return *manageClairResourceResult, nil
 		}
 
-		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemClairConfigurationSuccess, "", "Clair Configuration Updated Successfully")
+		_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemClairConfigurationSuccess, "Clair Configuration Updated Successfully")
 
 		if err != nil {
 			logging.Log.Error(err, "Failed to update QuayEcosystem after Clair configuration success")
@@ -368,6 +411,40 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 
 	}
 
+	// Mint the API token once Quay is serving requests
+	if quayConfiguration.QuayEcosystem.Status.SetupComplete && quayConfiguration.QuayEcosystem.Spec.Quay.APIToken != nil {
+
+		minted, err := r.quaySetupManager.ManageAPIToken(&quayConfiguration)
+		if err != nil {
+			r.reconcilerBase.GetRecorder().Event(quayConfiguration.QuayEcosystem, "Warning", "Failed to Manage Quay API Token", err.Error())
+			return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemAPITokenFailure, err)
+		}
+
+		if minted {
+			_, err = r.manageSuccess(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemAPITokenSuccess, "API Token Minted Successfully")
+
+			if err != nil {
+				logging.Log.Error(err, "Failed to update QuayEcosystem status after minting the API token")
+				return r.manageError(quayConfiguration.QuayEcosystem, redhatcopv1alpha1.QuayEcosystemAPITokenFailure, err)
+			}
+		}
+	}
+
+	// Verify that images can be pushed to and pulled from the registry
+	var registrySmokeTestRequeue time.Duration
+	if quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest == nil {
+		quayConfiguration.QuayEcosystem.RemoveCondition(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition)
+		quayConfiguration.QuayEcosystem.Status.SmokeTest = nil
+	} else if quayConfiguration.QuayEcosystem.Status.SetupComplete {
+		registrySmokeTestRequeue = r.quaySetupManager.ManageRegistrySmokeTest(&quayConfiguration)
+	}
+
+	// Publish the state of each component
+	if err := r.manageComplete(quayConfiguration.QuayEcosystem); err != nil {
+		logging.Log.Error(err, "Failed to update QuayEcosystem status after reconciliation")
+		return reconcile.Result{}, err
+	}
+
 	// Reconcile Quay configuration specified in the CR.
 	// TODO: This controller is too long. Refactor and clean it up.
 	// NOTE: This is a naive implementation for the sake of time and simplicity.
@@ -402,7 +479,7 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 		secret := &corev1.Secret{}
 		target := types.NamespacedName{
 			Namespace: request.Namespace,
-			Name:      "quay-enterprise-config-secret", // TODO: Should not be hard-coded
+			Name:      resources.GetQuaySecretName(quayConfiguration.QuayEcosystem),
 		}
 		err := r.reconcilerBase.GetClient().Get(context.TODO(), target, secret)
 		if err != nil {
@@ -563,22 +640,61 @@ func (r *ReconcileQuayEcosystem) Reconcile(request reconcile.Request) (reconcile
 		}
 	}
 
-	return reconcile.Result{}, nil
+	// Requeue for the next run of the registry smoke test
+	return reconcile.Result{RequeueAfter: registrySmokeTestRequeue}, nil
+
+}
+
+// updateStatus persists the status of the provided instance without modifying the stored specification
+func (r *ReconcileQuayEcosystem) updateStatus(instance *redhatcopv1alpha1.QuayEcosystem) error {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{}
+	err := r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}, quayEcosystem)
+
+	if err != nil {
+		return err
+	}
+
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.Components = r.getComponentsStatus(instance)
+	instance.Status.Replicas, instance.Status.LabelSelector = getScaleStatus(instance)
+	instance.SetCondition(getAvailableCondition(instance))
+	instance.SetCondition(getSetupCompleteCondition(instance))
+	instance.Status.Phase = getPhase(instance)
+
+	if reflect.DeepEqual(quayEcosystem.Status, instance.Status) {
+		return nil
+	}
+
+	quayEcosystem.Status = *instance.Status.DeepCopy()
+
+	err = r.reconcilerBase.GetClient().Status().Update(context.TODO(), quayEcosystem)
 
+	if err != nil {
+		return err
+	}
+
+	instance.ResourceVersion = quayEcosystem.ResourceVersion
+
+	return nil
 }
 
-func (r *ReconcileQuayEcosystem) manageSuccess(instance *redhatcopv1alpha1.QuayEcosystem, conditionType redhatcopv1alpha1.QuayEcosystemConditionType, reason string, message string) (reconcile.Result, error) {
+func (r *ReconcileQuayEcosystem) manageSuccess(instance *redhatcopv1alpha1.QuayEcosystem, reason redhatcopv1alpha1.QuayEcosystemConditionReason, message string) (reconcile.Result, error) {
 
-	condition := redhatcopv1alpha1.QuayEcosystemCondition{
-		Type:    conditionType,
-		Reason:  reason,
+	instance.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:    redhatcopv1alpha1.QuayEcosystemProgressingCondition,
+		Reason:  string(reason),
 		Message: message,
 		Status:  corev1.ConditionTrue,
-	}
+	})
 
-	instance.SetCondition(condition)
+	instance.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:   redhatcopv1alpha1.QuayEcosystemDegradedCondition,
+		Reason: string(reason),
+		Status: corev1.ConditionFalse,
+	})
 
-	err := r.reconcilerBase.GetClient().Status().Update(context.TODO(), instance)
+	err := r.updateStatus(instance)
 
 	if err != nil {
 		return reconcile.Result{
@@ -590,28 +706,52 @@ func (r *ReconcileQuayEcosystem) manageSuccess(instance *redhatcopv1alpha1.QuayE
 	return reconcile.Result{}, nil
 }
 
-func (r *ReconcileQuayEcosystem) manageError(instance *redhatcopv1alpha1.QuayEcosystem, conditionType redhatcopv1alpha1.QuayEcosystemConditionType, issue error) (reconcile.Result, error) {
+func (r *ReconcileQuayEcosystem) manageComplete(instance *redhatcopv1alpha1.QuayEcosystem) error {
+
+	instance.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:    redhatcopv1alpha1.QuayEcosystemProgressingCondition,
+		Reason:  string(redhatcopv1alpha1.QuayEcosystemReconcileComplete),
+		Message: "All resources have been reconciled",
+		Status:  corev1.ConditionFalse,
+	})
+
+	instance.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:   redhatcopv1alpha1.QuayEcosystemDegradedCondition,
+		Reason: string(redhatcopv1alpha1.QuayEcosystemReconcileComplete),
+		Status: corev1.ConditionFalse,
+	})
+
+	return r.updateStatus(instance)
+}
+
+func (r *ReconcileQuayEcosystem) manageError(instance *redhatcopv1alpha1.QuayEcosystem, reason redhatcopv1alpha1.QuayEcosystemConditionReason, issue error) (reconcile.Result, error) {
 
 	r.reconcilerBase.GetRecorder().Event(instance, "Warning", "ProcessingError", issue.Error())
 
-	existingCondition, found := instance.FindConditionByType(conditionType)
+	existingCondition, found := instance.FindConditionByType(redhatcopv1alpha1.QuayEcosystemDegradedCondition)
 
 	lastUpdate := existingCondition.LastUpdateTime
+	wasDegraded := found && existingCondition.Status == corev1.ConditionTrue
 
 	if !found {
 		lastUpdate = metav1.NewTime(time.Now())
 	}
 
-	condition := redhatcopv1alpha1.QuayEcosystemCondition{
-		Type:    conditionType,
-		Reason:  "ProcessingError",
+	instance.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:    redhatcopv1alpha1.QuayEcosystemDegradedCondition,
+		Reason:  string(reason),
 		Message: issue.Error(),
-		Status:  corev1.ConditionFalse,
-	}
+		Status:  corev1.ConditionTrue,
+	})
 
-	instance.SetCondition(condition)
+	instance.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:    redhatcopv1alpha1.QuayEcosystemProgressingCondition,
+		Reason:  string(reason),
+		Message: issue.Error(),
+		Status:  corev1.ConditionFalse,
+	})
 
-	err := r.reconcilerBase.GetClient().Status().Update(context.TODO(), instance)
+	err := r.updateStatus(instance)
 
 	if err != nil {
 		return reconcile.Result{
@@ -621,7 +761,7 @@ func (r *ReconcileQuayEcosystem) manageError(instance *redhatcopv1alpha1.QuayEco
 	}
 
 	var retryInterval time.Duration
-	if !found || existingCondition.Status == corev1.ConditionTrue {
+	if !wasDegraded {
 		retryInterval = time.Second
 	} else {
 		retryInterval = time.Now().Sub(lastUpdate.Time).Round(time.Second)
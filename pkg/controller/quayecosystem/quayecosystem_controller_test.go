@@ -76,8 +76,13 @@ func TestDefaultConfiguration(t *testing.T) {
 	crd := &redhatcopv1alpha1.QuayEcosystem{}
 	err = cl.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, crd)
 	assert.NoError(t, err)
-	// Make sure one of the default values was assigned
-	assert.Equal(t, crd.Spec.Quay.Image, constants.QuayImage)
+	// Make sure defaults were not written back into the specification
+	assert.Nil(t, crd.Spec.Quay)
+	// Make sure one of the default values was published in the effective specification
+	effectiveSpec, err := crd.GetEffectiveSpec()
+	assert.NoError(t, err)
+	assert.NotNil(t, effectiveSpec)
+	assert.Equal(t, effectiveSpec.Quay.Image, constants.QuayImage)
 
 }
 
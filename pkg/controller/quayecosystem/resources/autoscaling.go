@@ -0,0 +1,40 @@
+package resources
+
+import (
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetHorizontalPodAutoscalerDefinition returns a HorizontalPodAutoscaler which scales the provided Deployment
+func GetHorizontalPodAutoscalerDefinition(deployment *appsv1.Deployment, horizontalPodAutoscaler *redhatcopv1alpha1.HorizontalPodAutoscaler) *autoscalingv1.HorizontalPodAutoscaler {
+
+	meta := metav1.ObjectMeta{
+		Name:      deployment.Name,
+		Namespace: deployment.Namespace,
+		Labels:    map[string]string{},
+	}
+
+	for key, value := range deployment.Labels {
+		meta.Labels[key] = value
+	}
+
+	return &autoscalingv1.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: autoscalingv1.SchemeGroupVersion.String(),
+			Kind:       "HorizontalPodAutoscaler",
+		},
+		ObjectMeta: meta,
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+			},
+			MinReplicas:                    horizontalPodAutoscaler.MinReplicas,
+			MaxReplicas:                    horizontalPodAutoscaler.MaxReplicas,
+			TargetCPUUtilizationPercentage: horizontalPodAutoscaler.TargetCPUUtilizationPercentage,
+		},
+	}
+}
@@ -303,7 +303,8 @@ func GetQuayRepoMirrorDeploymentDefinition(meta metav1.ObjectMeta, quayConfigura
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: meta.Labels,
+					Labels:      meta.Labels,
+					Annotations: getConfigChecksumAnnotations(quayConfiguration.QuayConfigChecksum),
 				},
 				Spec: quayDeploymentPodSpec,
 			},
@@ -436,7 +437,8 @@ func GetQuayDeploymentDefinition(meta metav1.ObjectMeta, quayConfiguration *Quay
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: meta.Labels,
+					Labels:      meta.Labels,
+					Annotations: getConfigChecksumAnnotations(quayConfiguration.QuayConfigChecksum),
 				},
 				Spec: quayDeploymentPodSpec,
 			},
@@ -619,7 +621,8 @@ func GetClairDeploymentDefinition(meta metav1.ObjectMeta, quayConfiguration *Qua
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: meta.Labels,
+					Labels:      meta.Labels,
+					Annotations: getConfigChecksumAnnotations(quayConfiguration.ClairConfigChecksum),
 				},
 				Spec: clairDeploymentPodSpec,
 			},
@@ -769,6 +772,18 @@ func GetDatabaseDeploymentDefinition(meta metav1.ObjectMeta, quayConfiguration *
 
 }
 
+// getConfigChecksumAnnotations returns the pod template annotations that trigger a rolling update when the configuration changes
+func getConfigChecksumAnnotations(checksum string) map[string]string {
+
+	if checksum == "" {
+		return nil
+	}
+
+	return map[string]string{
+		constants.ConfigChecksumAnnotationKey: checksum,
+	}
+}
+
 func getBaselineQuayVolumeProjections(quayConfiguration *QuayConfiguration) []corev1.VolumeProjection {
 
 	configVolumeProjections := []corev1.VolumeProjection{
@@ -2,13 +2,31 @@ package resources
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
+	"time"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/utils"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // GetDefaultHTTPClient returns a configured HTTP Client
-func GetDefaultHTTPClient() *http.Client {
+// Connections are bounded by timeouts while requests are bounded by the deadline of their context
+func GetDefaultHTTPClient(tlsConfig *tls.Config) *http.Client {
 	t := http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+		IdleConnTimeout:     90 * time.Second,
+		TLSClientConfig:     tlsConfig,
 	}
 	httpClient := http.Client{
 		Transport: &t,
@@ -17,3 +35,130 @@ func GetDefaultHTTPClient() *http.Client {
 	return &httpClient
 
 }
+
+// GetQuayTLSConfig returns the TLS configuration verifying the certificate presented by Quay or its config app at the provided hostname
+// Certificates must be issued by the system roots or one of the provided PEM encoded certificates unless the QuayEcosystem opts out of verification
+// The configuration is specific to the hostname so each client of Quay or its config app needs its own
+func GetQuayTLSConfig(quayEcosystem *redhatcopv1alpha1.QuayEcosystem, certificates [][]byte, hostname string) *tls.Config {
+
+	if quayEcosystem.IsTLSVerificationSkipped() {
+		return &tls.Config{InsecureSkipVerify: true}
+	}
+
+	roots, err := x509.SystemCertPool()
+	if err != nil || roots == nil {
+		roots = x509.NewCertPool()
+	}
+
+	for _, certificate := range certificates {
+		roots.AppendCertsFromPEM(certificate)
+	}
+
+	return &tls.Config{
+		RootCAs:    roots,
+		ServerName: utils.GetHostFromHostname(hostname),
+	}
+}
+
+// WrapTLSVerificationError explains how to trust the issuer of a certificate that failed verification
+// Other errors are returned unchanged
+func WrapTLSVerificationError(err error) error {
+
+	var unknownAuthorityError x509.UnknownAuthorityError
+	var hostnameError x509.HostnameError
+	var certificateInvalidError x509.CertificateInvalidError
+
+	if errors.As(err, &unknownAuthorityError) || errors.As(err, &hostnameError) || errors.As(err, &certificateInvalidError) {
+		return fmt.Errorf("%w. Add the issuing CA as an extraCaCert config file or set insecureSkipVerify to disable verification", err)
+	}
+
+	return err
+}
+
+// GetQuayConfigurationTLSConfig returns the TLS configuration verifying Quay or its config app at the provided hostname against the certificates of the configuration
+func GetQuayConfigurationTLSConfig(quayConfiguration *QuayConfiguration, hostname string) *tls.Config {
+	return GetQuayTLSConfig(quayConfiguration.QuayEcosystem, getQuayConfigurationCertificates(quayConfiguration), hostname)
+}
+
+// GetQuayConfigAppTLSConfig returns the TLS configuration verifying the config app against the certificate it serves
+// The config app serves the certificate of Quay which is not necessarily valid for the config hostname so it is verified against the Quay hostname
+// The config hostname is still presented as server name since the passthrough route of the config app is selected by it
+func GetQuayConfigAppTLSConfig(quayConfiguration *QuayConfiguration) *tls.Config {
+
+	tlsConfig := GetQuayConfigurationTLSConfig(quayConfiguration, quayConfiguration.QuayConfigHostname)
+
+	if tlsConfig.InsecureSkipVerify {
+		return tlsConfig
+	}
+
+	roots := tlsConfig.RootCAs
+	quayServerName := utils.GetHostFromHostname(quayConfiguration.QuayHostname)
+
+	// The standard verification only accepts certificates valid for the server name
+	tlsConfig.InsecureSkipVerify = true
+	tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		return verifyPeerCertificate(rawCerts, roots, quayServerName)
+	}
+
+	return tlsConfig
+}
+
+// verifyPeerCertificate verifies that the certificate chain presented by the server is issued by one of the roots for the hostname
+func verifyPeerCertificate(rawCerts [][]byte, roots *x509.CertPool, hostname string) error {
+
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("No certificate presented by the server")
+	}
+
+	certificates := []*x509.Certificate{}
+	for _, rawCert := range rawCerts {
+		certificate, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		DNSName:       hostname,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+
+	return err
+}
+
+// getQuayConfigurationCertificates returns the certificates of Quay and Clair and the extra CA certificates of the configuration
+func getQuayConfigurationCertificates(quayConfiguration *QuayConfiguration) [][]byte {
+
+	certificates := [][]byte{quayConfiguration.QuaySslCertificate, quayConfiguration.ClairSslCertificate}
+
+	for _, configFiles := range quayConfiguration.QuayConfigFiles {
+		for _, file := range configFiles.Files {
+			if file.Type == redhatcopv1alpha1.ExtraCaCertConfigFileType || configFiles.Type == redhatcopv1alpha1.ExtraCaCertConfigFileType {
+				certificates = append(certificates, file.SecretContent)
+			}
+		}
+	}
+
+	return certificates
+}
+
+// GetQuayConfigSecretCertificates returns the certificate of Quay and the extra CA certificates stored in the Quay config secret
+func GetQuayConfigSecretCertificates(secret *corev1.Secret) [][]byte {
+
+	certificates := [][]byte{}
+
+	for key, value := range secret.Data {
+		if key == constants.QuayAppConfigSSLCertificateSecretKey || strings.HasPrefix(key, constants.ExtraCaCertsFilenamePrefix) {
+			certificates = append(certificates, value)
+		}
+	}
+
+	return certificates
+}
@@ -0,0 +1,234 @@
+package resources
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/cert"
+)
+
+// newCertificateServer returns a server presenting a self signed certificate issued for the hostname along with the certificate
+func newCertificateServer(t *testing.T, hostname string) (*httptest.Server, []byte) {
+
+	certBytes, privKeyBytes, err := cert.GenerateSelfSignedCertKey(hostname, nil, []string{hostname})
+	assert.NoError(t, err)
+
+	keyPair, err := tls.X509KeyPair(certBytes, privKeyBytes)
+	assert.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{keyPair}}
+	server.StartTLS()
+
+	return server, certBytes
+}
+
+func newQuayEcosystem(insecureSkipVerify bool) *redhatcopv1alpha1.QuayEcosystem {
+	return &redhatcopv1alpha1.QuayEcosystem{
+		Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+			Quay: &redhatcopv1alpha1.Quay{
+				ExternalAccess: &redhatcopv1alpha1.ExternalAccess{
+					TLS: &redhatcopv1alpha1.TLSExternalAccess{
+						Termination:        redhatcopv1alpha1.PassthroughTLSTerminationType,
+						InsecureSkipVerify: insecureSkipVerify,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestQuayTLSConfig(t *testing.T) {
+
+	server, quayCertificate := newCertificateServer(t, "quay.example.com")
+	defer server.Close()
+
+	cases := []struct {
+		name          string
+		quayEcosystem *redhatcopv1alpha1.QuayEcosystem
+		certificates  [][]byte
+		hostname      string
+		trusted       bool
+	}{
+		{
+			name:          "TrustedCertificate",
+			quayEcosystem: newQuayEcosystem(false),
+			certificates:  [][]byte{quayCertificate},
+			hostname:      "quay.example.com",
+			trusted:       true,
+		},
+		{
+			name:          "HostnameWithPort",
+			quayEcosystem: newQuayEcosystem(false),
+			certificates:  [][]byte{quayCertificate},
+			hostname:      "quay.example.com:443",
+			trusted:       true,
+		},
+		{
+			// A certificate valid for another hostname of the QuayEcosystem is not accepted
+			name:          "CertificateOfAnotherHostname",
+			quayEcosystem: newQuayEcosystem(false),
+			certificates:  [][]byte{quayCertificate},
+			hostname:      "quay-config.example.com",
+			trusted:       false,
+		},
+		{
+			name:          "UnknownHostname",
+			quayEcosystem: newQuayEcosystem(false),
+			certificates:  [][]byte{quayCertificate},
+			hostname:      "registry.example.com",
+			trusted:       false,
+		},
+		{
+			name:          "UntrustedCertificate",
+			quayEcosystem: newQuayEcosystem(false),
+			hostname:      "quay.example.com",
+			trusted:       false,
+		},
+		{
+			name:          "SkippedVerification",
+			quayEcosystem: newQuayEcosystem(true),
+			hostname:      "quay.example.com",
+			trusted:       true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			httpClient := GetDefaultHTTPClient(GetQuayTLSConfig(c.quayEcosystem, c.certificates, c.hostname))
+
+			resp, err := httpClient.Get(server.URL)
+
+			if c.trusted {
+				assert.NoError(t, err)
+				resp.Body.Close()
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, WrapTLSVerificationError(err).Error(), "extraCaCert")
+			}
+		})
+	}
+}
+
+func TestQuayConfigAppTLSConfig(t *testing.T) {
+
+	certBytes, privKeyBytes, err := cert.GenerateSelfSignedCertKey("quay.example.com", nil, []string{"quay.example.com"})
+	assert.NoError(t, err)
+
+	keyPair, err := tls.X509KeyPair(certBytes, privKeyBytes)
+	assert.NoError(t, err)
+
+	// The config app serves the certificate of Quay
+	serverNames := make(chan string, 2)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			serverNames <- hello.ServerName
+			return &keyPair, nil
+		},
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	cases := []struct {
+		name         string
+		quayHostname string
+		trusted      bool
+	}{
+		{
+			name:         "CertificateOfQuay",
+			quayHostname: "quay.example.com",
+			trusted:      true,
+		},
+		{
+			name:         "CertificateOfAnotherHostname",
+			quayHostname: "registry.example.com",
+			trusted:      false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			quayConfiguration := &QuayConfiguration{
+				QuayEcosystem:      newQuayEcosystem(false),
+				QuayHostname:       c.quayHostname,
+				QuayConfigHostname: "quay-config.example.com",
+				QuaySslCertificate: certBytes,
+			}
+
+			httpClient := GetDefaultHTTPClient(GetQuayConfigAppTLSConfig(quayConfiguration))
+
+			resp, err := httpClient.Get(server.URL)
+
+			// The config hostname selects the route of the config app
+			assert.Equal(t, "quay-config.example.com", <-serverNames)
+
+			if c.trusted {
+				assert.NoError(t, err)
+				resp.Body.Close()
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, WrapTLSVerificationError(err).Error(), "extraCaCert")
+			}
+		})
+	}
+}
+
+func TestWrapTLSVerificationError(t *testing.T) {
+
+	err := fmt.Errorf("Connection refused")
+
+	assert.Equal(t, err, WrapTLSVerificationError(err))
+
+	wrapped := WrapTLSVerificationError(x509.UnknownAuthorityError{})
+
+	assert.Contains(t, wrapped.Error(), "insecureSkipVerify")
+	assert.True(t, errors.As(wrapped, &x509.UnknownAuthorityError{}))
+}
+
+func TestQuayConfigurationCertificates(t *testing.T) {
+
+	quayConfiguration := &QuayConfiguration{
+		QuayEcosystem:       newQuayEcosystem(false),
+		QuaySslCertificate:  []byte("quay"),
+		ClairSslCertificate: []byte("clair"),
+		QuayConfigFiles: []redhatcopv1alpha1.ConfigFiles{
+			{
+				Type: redhatcopv1alpha1.ExtraCaCertConfigFileType,
+				Files: []redhatcopv1alpha1.ConfigFile{
+					{Key: "ca.crt", SecretContent: []byte("ca")},
+				},
+			},
+			{
+				Files: []redhatcopv1alpha1.ConfigFile{
+					{Key: "config.yaml", Type: redhatcopv1alpha1.ConfigConfigFileType, SecretContent: []byte("config")},
+					{Key: "other.crt", Type: redhatcopv1alpha1.ExtraCaCertConfigFileType, SecretContent: []byte("other")},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, [][]byte{[]byte("quay"), []byte("clair"), []byte("ca"), []byte("other")}, getQuayConfigurationCertificates(quayConfiguration))
+
+	configSecret := &corev1.Secret{
+		Data: map[string][]byte{
+			constants.QuayAppConfigSSLCertificateSecretKey:  []byte("quay"),
+			constants.QuayAppConfigSSLPrivateKeySecretKey:   []byte("key"),
+			constants.ExtraCaCertsFilenamePrefix + "ca.crt": []byte("ca"),
+			"config.yaml": []byte("config"),
+		},
+	}
+
+	assert.ElementsMatch(t, [][]byte{[]byte("quay"), []byte("ca")}, GetQuayConfigSecretCertificates(configSecret))
+}
@@ -102,6 +102,11 @@ func GetQuaySSLSecretName(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) string
 	return fmt.Sprintf("%s-quay-ssl", GetGenericResourcesName(quayEcosystem))
 }
 
+// GetQuayAPITokenSecretName returns the default name of the secret containing the OAuth token for the Quay API
+func GetQuayAPITokenSecretName(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) string {
+	return fmt.Sprintf("%s-quay-api-token", GetGenericResourcesName(quayEcosystem))
+}
+
 // GetSCCResourcesName returns name of resource related to SCC management
 func GetSCCResourcesName(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) string {
 	return fmt.Sprintf("%s-scc", GetGenericResourcesName(quayEcosystem))
@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"encoding/base64"
+	"encoding/json"
+
 	corev1 "k8s.io/api/core/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,3 +49,36 @@ func GetTLSSecretDefinition(meta metav1.ObjectMeta, tlsPrivateKey []byte, tlsCer
 		},
 	}
 }
+
+// GetDockerConfigSecretDefinition returns a Secret containing the credentials to access the registry at the provided hostname
+func GetDockerConfigSecretDefinition(meta metav1.ObjectMeta, hostname string, username string, password string) (*corev1.Secret, error) {
+
+	dockerConfig := map[string]interface{}{
+		"auths": map[string]interface{}{
+			hostname: map[string]string{
+				"username": username,
+				"password": password,
+				"email":    "",
+				"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	}
+
+	dockerConfigJSON, err := json.Marshal(dockerConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: meta,
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: dockerConfigJSON,
+		},
+	}, nil
+}
@@ -48,12 +48,15 @@ type QuayConfiguration struct {
 	SecurityScannerKeyID                  string
 	RegistryBackends                      []redhatcopv1alpha1.RegistryBackend
 	QuayConfigFiles                       []redhatcopv1alpha1.ConfigFiles
+	QuayConfigChecksum                    string
+	RegistrySmokeTestInterval             time.Duration
 
 	// Clair
 	ClairSslCertificate []byte
 	ClairSslPrivateKey  []byte
 	ClairUpdateInterval time.Duration
 	ClairConfigFiles    []redhatcopv1alpha1.ConfigFiles
+	ClairConfigChecksum string
 
 	IsOpenShift                bool
 	RequiredSCCServiceAccounts []string
@@ -251,3 +254,44 @@ func CloudfrontS3RegistryBackendToQuayCloudfrontS3RegistryBackend(cloudfrontS3Re
 		StoragePath:        cloudfrontS3RegistryBackend.StoragePath,
 	}
 }
+
+// GetRegistryBackendCredentials returns the credentials of a registry backend keyed by the name of the storage parameter holding them in the Quay configuration
+func GetRegistryBackendCredentials(registryBackend redhatcopv1alpha1.RegistryBackend) map[string]string {
+
+	credentials := map[string]string{}
+
+	addCredential := func(key string, value string) {
+		if value != "" {
+			credentials[key] = value
+		}
+	}
+
+	source := registryBackend.RegistryBackendSource
+
+	switch {
+	case source.S3 != nil:
+		addCredential("s3_access_key", source.S3.AccessKey)
+		addCredential("s3_secret_key", source.S3.SecretKey)
+	case source.GoogleCloud != nil:
+		addCredential("access_key", source.GoogleCloud.AccessKey)
+		addCredential("secret_key", source.GoogleCloud.SecretKey)
+	case source.Azure != nil:
+		addCredential("azure_account_name", source.Azure.AccountName)
+		addCredential("azure_account_key", source.Azure.AccountKey)
+		addCredential("sas_token", source.Azure.SasToken)
+	case source.RADOS != nil:
+		addCredential("access_key", source.RADOS.AccessKey)
+		addCredential("secret_key", source.RADOS.SecretKey)
+	case source.RHOCS != nil:
+		addCredential("access_key", source.RHOCS.AccessKey)
+		addCredential("secret_key", source.RHOCS.SecretKey)
+	case source.Swift != nil:
+		addCredential("swift_user", source.Swift.User)
+		addCredential("swift_password", source.Swift.Password)
+	case source.CloudfrontS3 != nil:
+		addCredential("s3_access_key", source.CloudfrontS3.AccessKey)
+		addCredential("s3_secret_key", source.CloudfrontS3.SecretKey)
+	}
+
+	return credentials
+}
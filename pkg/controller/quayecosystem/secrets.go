@@ -0,0 +1,54 @@
+package quayecosystem
+
+import (
+	"context"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// referencedSecretsIndexField is the field used to index QuayEcosystems by the Secrets referenced in their specification
+const referencedSecretsIndexField = "spec.referencedSecrets"
+
+// indexReferencedSecrets extracts the names of the Secrets referenced by a QuayEcosystem
+func indexReferencedSecrets(obj runtime.Object) []string {
+
+	quayEcosystem, ok := obj.(*redhatcopv1alpha1.QuayEcosystem)
+
+	if !ok {
+		return nil
+	}
+
+	return quayEcosystem.GetReferencedSecretNames()
+}
+
+// referencedSecretMapper maps a Secret to requests for the QuayEcosystems referencing it
+type referencedSecretMapper struct {
+	client client.Client
+}
+
+// Map returns a request for each QuayEcosystem in the namespace of the Secret that references it
+func (m *referencedSecretMapper) Map(obj handler.MapObject) []reconcile.Request {
+
+	quayEcosystems := &redhatcopv1alpha1.QuayEcosystemList{}
+
+	err := m.client.List(context.TODO(), quayEcosystems, client.InNamespace(obj.Meta.GetNamespace()), client.MatchingFields{referencedSecretsIndexField: obj.Meta.GetName()})
+
+	if err != nil {
+		logging.Log.Error(err, "Failed to List QuayEcosystems Referencing Secret", "Namespace", obj.Meta.GetNamespace(), "Name", obj.Meta.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for _, quayEcosystem := range quayEcosystems.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: quayEcosystem.Namespace, Name: quayEcosystem.Name}})
+	}
+
+	return requests
+}
@@ -0,0 +1,79 @@
+package quayecosystem
+
+import (
+	"context"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// indexedClient lists QuayEcosystems using the referenced Secrets index in the way the cache of the manager does
+type indexedClient struct {
+	client.Client
+	quayEcosystems []redhatcopv1alpha1.QuayEcosystem
+}
+
+func (c *indexedClient) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+
+	listOptions := &client.ListOptions{}
+	listOptions.ApplyOptions(opts)
+
+	secretName, _ := listOptions.FieldSelector.RequiresExactMatch(referencedSecretsIndexField)
+
+	quayEcosystems := list.(*redhatcopv1alpha1.QuayEcosystemList)
+
+	for _, quayEcosystem := range c.quayEcosystems {
+
+		if quayEcosystem.Namespace != listOptions.Namespace {
+			continue
+		}
+
+		for _, indexedSecretName := range indexReferencedSecrets(quayEcosystem.DeepCopy()) {
+			if indexedSecretName == secretName {
+				quayEcosystems.Items = append(quayEcosystems.Items, quayEcosystem)
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestReferencedSecretMapper(t *testing.T) {
+
+	newQuayEcosystem := func(namespace string, name string, credentialsSecretName string) redhatcopv1alpha1.QuayEcosystem {
+		return redhatcopv1alpha1.QuayEcosystem{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+				Quay: &redhatcopv1alpha1.Quay{
+					RegistryBackends: []redhatcopv1alpha1.RegistryBackend{
+						{Name: "default", CredentialsSecretName: credentialsSecretName},
+					},
+				},
+			},
+		}
+	}
+
+	mapper := &referencedSecretMapper{client: &indexedClient{quayEcosystems: []redhatcopv1alpha1.QuayEcosystem{
+		newQuayEcosystem(namespace, name, "s3-credentials"),
+		newQuayEcosystem(namespace, "other-quay", "other-s3-credentials"),
+		newQuayEcosystem("other-namespace", name, "s3-credentials"),
+	}}}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "s3-credentials", Namespace: namespace}}
+
+	requests := mapper.Map(handler.MapObject{Meta: secret, Object: secret})
+
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}, requests)
+
+	unreferencedSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unreferenced", Namespace: namespace}}
+
+	assert.Empty(t, mapper.Map(handler.MapObject{Meta: unreferencedSecret, Object: unreferencedSecret}))
+}
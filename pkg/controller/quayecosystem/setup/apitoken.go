@@ -0,0 +1,178 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ManageAPIToken mints an OAuth token for the Quay API on behalf of the initial superuser and stores it in a Secret referenced from the status of the QuayEcosystem
+// A new token is minted when the scopes, organization or Secret change, when the Secret is missing or when the regeneration of the token is requested. Returns whether a token was minted
+func (qm *QuaySetupManager) ManageAPIToken(quayConfiguration *resources.QuayConfiguration) (bool, error) {
+
+	quayEcosystem := quayConfiguration.QuayEcosystem
+	apiToken := quayEcosystem.Spec.Quay.APIToken
+	apiTokenStatus := quayEcosystem.Status.APIToken
+	regenerationRequest := quayEcosystem.Annotations[constants.RegenerateTokenAnnotationKey]
+
+	secretFound, err := qm.apiTokenSecretExists(quayEcosystem.Namespace, apiToken.SecretName)
+
+	if err != nil {
+		return false, err
+	}
+
+	if secretFound && apiTokenStatus != nil && apiTokenStatus.SecretName == apiToken.SecretName && apiTokenStatus.Organization == apiToken.Organization && reflect.DeepEqual(apiTokenStatus.Scopes, apiToken.Scopes) && (regenerationRequest == "" || regenerationRequest == apiTokenStatus.RegenerationRequest) {
+		return false, nil
+	}
+
+	logging.Log.Info("Minting Quay API Token", "Namespace", quayEcosystem.Namespace, "Name", quayEcosystem.Name, "Organization", apiToken.Organization)
+
+	// The credentials of the configuration are only read from the superuser Secret until setup has completed
+	username, password, err := quayapi.GetSuperuserCredentials(qm.reconcilerBase.GetClient(), quayEcosystem)
+
+	if err != nil {
+		return false, err
+	}
+
+	quayClient := quayapi.NewQuayClient(quayEcosystem, quayConfiguration.QuayHostname, username, password, resources.GetQuayConfigurationTLSConfig(quayConfiguration, quayConfiguration.QuayHostname))
+
+	// Quay does not accept basic authentication for the management of organizations and applications so the token is minted within a signed in session
+	_, quayClient, err = quayClient.SignIn(context.TODO())
+
+	if err != nil {
+		return false, fmt.Errorf("Failed to sign in to Quay: %s", resources.WrapTLSVerificationError(err).Error())
+	}
+
+	err = ensureOrganization(context.TODO(), quayClient, apiToken.Organization)
+
+	if err != nil {
+		return false, fmt.Errorf("Failed to create organization %s: %s", apiToken.Organization, err.Error())
+	}
+
+	_, application, err := quayClient.CreateOrganizationApplication(context.TODO(), apiToken.Organization, client.ApplicationCreateRequest{
+		Name:        constants.QuayAPITokenApplicationName,
+		RedirectURI: fmt.Sprintf("%s/oauth/localapp", quayClient.BaseURL.String()),
+		Description: "Issues the API token managed by the Quay Operator",
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("Failed to create OAuth application: %s", err.Error())
+	}
+
+	scopes := []string{}
+	for _, scope := range apiToken.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	_, token, err := quayClient.CreateApplicationAccessToken(context.TODO(), application.ClientID, application.RedirectURI, scopes)
+
+	if err != nil {
+		return false, fmt.Errorf("Failed to mint API token: %s", err.Error())
+	}
+
+	secret := resources.GetSecretDefinition(resources.UpdateMetaWithName(resources.NewResourceObjectMeta(quayEcosystem), apiToken.SecretName))
+	secret.Data[constants.QuayAPITokenSecretKey] = []byte(token)
+
+	err = qm.reconcilerBase.CreateOrUpdateResource(quayEcosystem, quayEcosystem.Namespace, secret)
+
+	if err != nil {
+		return false, err
+	}
+
+	// Tokens issued for previous applications are revoked along with their application
+	err = revokePreviousApplications(quayClient, apiToken.Organization, application.ClientID)
+
+	if err != nil {
+		return false, fmt.Errorf("Failed to revoke previous API tokens: %s", err.Error())
+	}
+
+	if apiTokenStatus != nil && apiTokenStatus.SecretName != apiToken.SecretName {
+
+		previousSecret := resources.GetSecretDefinition(resources.UpdateMetaWithName(resources.NewResourceObjectMeta(quayEcosystem), apiTokenStatus.SecretName))
+
+		err = qm.reconcilerBase.GetClient().Delete(context.TODO(), previousSecret)
+
+		if err != nil && !apierrors.IsNotFound(err) {
+			return false, err
+		}
+	}
+
+	creationTime := metav1.Now()
+
+	quayEcosystem.Status.APIToken = &redhatcopv1alpha1.APITokenStatus{
+		SecretName:          apiToken.SecretName,
+		Organization:        apiToken.Organization,
+		ClientID:            application.ClientID,
+		Scopes:              apiToken.Scopes,
+		RegenerationRequest: regenerationRequest,
+		CreationTime:        &creationTime,
+	}
+
+	return true, nil
+}
+
+// apiTokenSecretExists determines whether the Secret containing the API token exists
+func (qm *QuaySetupManager) apiTokenSecretExists(namespace string, name string) (bool, error) {
+
+	secret := &corev1.Secret{}
+	err := qm.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, secret)
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return len(secret.Data[constants.QuayAPITokenSecretKey]) > 0, nil
+}
+
+// ensureOrganization creates the organization administered by the initial superuser when it does not exist
+func ensureOrganization(ctx context.Context, quayClient *client.QuayClient, organization string) error {
+
+	_, _, err := quayClient.GetOrganization(ctx, organization)
+
+	if !client.IsNotFound(err) {
+		return err
+	}
+
+	_, _, err = quayClient.CreateOrganization(ctx, client.OrganizationCreateRequest{Name: organization})
+
+	return err
+}
+
+// revokePreviousApplications deletes the OAuth applications of the operator other than the current one
+func revokePreviousApplications(quayClient *client.QuayClient, organization string, clientID string) error {
+
+	_, applications, err := quayClient.GetOrganizationApplications(context.TODO(), organization)
+
+	if err != nil {
+		return err
+	}
+
+	for _, application := range applications.Applications {
+
+		if application.Name != constants.QuayAPITokenApplicationName || application.ClientID == clientID {
+			continue
+		}
+
+		_, err := quayClient.DeleteOrganizationApplication(context.TODO(), organization, application.ClientID)
+
+		if err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
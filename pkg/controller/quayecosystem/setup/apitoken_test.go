@@ -0,0 +1,252 @@
+package setup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// fakeQuay is the state held by the fake Quay server
+type fakeQuay struct {
+	// username and password are the credentials of the superuser
+	username      string
+	password      string
+	organizations map[string]bool
+	applications  []qclient.Application
+	// scopes are the scopes requested for the last minted token
+	scopes string
+	tokens int
+}
+
+// newFakeQuay returns the state of a fake Quay server whose superuser has the default credentials
+func newFakeQuay() *fakeQuay {
+	return &fakeQuay{
+		username:      constants.InitialQuaySuperuserDefaultUsername,
+		password:      constants.InitialQuaySuperuserDefaultPassword,
+		organizations: map[string]bool{},
+	}
+}
+
+// newQuayServer returns a server implementing the organization, OAuth application, sign in and application authorization endpoints of Quay
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return httptest.NewServer(newQuayHandler(quay))
+}
+
+// newQuayHandler returns the handler of the endpoints implemented by the fake Quay server
+func newQuayHandler(quay *fakeQuay) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		switch {
+		case r.URL.Path == "/csrf_token":
+			json.NewEncoder(w).Encode(qclient.CSRFToken{CSRFToken: "csrf"})
+		case r.URL.Path == "/api/v1/signin":
+			request := qclient.SigninRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			if request.Username != quay.username || request.Password != quay.password || r.Header.Get("X-CSRF-Token") != "csrf" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "_csrf_token", Value: "session", Path: "/"})
+			json.NewEncoder(w).Encode(map[string]bool{"success": true})
+		case r.URL.Path == "/oauth/authorizeapp":
+			if _, err := r.Cookie("_csrf_token"); err != nil || r.FormValue("_csrf_token") != "csrf" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			quay.tokens++
+			quay.scopes = r.FormValue("scope")
+			http.Redirect(w, r, fmt.Sprintf("%s#access_token=token-%d&token_type=Bearer", r.FormValue("redirect_uri"), quay.tokens), http.StatusFound)
+		case strings.HasPrefix(r.URL.Path, "/api/v1/organization/") && !isSignedIn(r):
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/api/v1/organization/" && r.Method == http.MethodPost:
+			request := qclient.OrganizationCreateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			quay.organizations[request.Name] = true
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`"Created"`))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/organization/"):
+			parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/organization/"), "/")
+
+			switch {
+			case !quay.organizations[parts[0]]:
+				w.WriteHeader(http.StatusNotFound)
+			case len(parts) == 1:
+				json.NewEncoder(w).Encode(qclient.Organization{Name: parts[0]})
+			case r.Method == http.MethodGet:
+				json.NewEncoder(w).Encode(qclient.Applications{Applications: quay.applications})
+			case r.Method == http.MethodPost:
+				request := qclient.ApplicationCreateRequest{}
+				json.NewDecoder(r.Body).Decode(&request)
+				application := qclient.Application{Name: request.Name, ClientID: fmt.Sprintf("client-%d", len(quay.applications)+quay.tokens), RedirectURI: request.RedirectURI}
+				quay.applications = append(quay.applications, application)
+				json.NewEncoder(w).Encode(application)
+			case r.Method == http.MethodDelete:
+				applications := []qclient.Application{}
+				for _, application := range quay.applications {
+					if application.ClientID != parts[2] {
+						applications = append(applications, application)
+					}
+				}
+				quay.applications = applications
+				w.WriteHeader(http.StatusNoContent)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+// isSignedIn determines whether the request was made within the session started by signing in to the fake Quay server
+func isSignedIn(r *http.Request) bool {
+	_, err := r.Cookie("_csrf_token")
+	return err == nil && r.Header.Get("X-CSRF-Token") == "csrf"
+}
+
+// newSuperuserSecret returns the Secret named quay-superuser containing the credentials of the superuser of the fake Quay server
+func newSuperuserSecret(quay *fakeQuay) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quay-superuser",
+			Namespace: "quay-enterprise",
+		},
+		Data: map[string][]byte{
+			constants.InitialQuaySuperuserUsernameKey: []byte(quay.username),
+			constants.InitialQuaySuperuserPasswordKey: []byte(quay.password),
+			constants.InitialQuaySuperuserEmailKey:    []byte("admin@example.com"),
+		},
+	}
+}
+
+func newQuayConfiguration(t *testing.T, server *httptest.Server) *resources.QuayConfiguration {
+
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	return &resources.QuayConfiguration{
+		QuayEcosystem: &redhatcopv1alpha1.QuayEcosystem{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "quay-ecosystem",
+				Namespace: "quay-enterprise",
+			},
+			Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+				Quay: &redhatcopv1alpha1.Quay{
+					ExternalAccess: &redhatcopv1alpha1.ExternalAccess{
+						TLS: &redhatcopv1alpha1.TLSExternalAccess{
+							Termination: redhatcopv1alpha1.NoneTLSTerminationType,
+						},
+					},
+					APIToken: &redhatcopv1alpha1.APIToken{
+						Organization: constants.QuayAPITokenDefaultOrganization,
+						Scopes:       []redhatcopv1alpha1.QuayAPITokenScope{redhatcopv1alpha1.RepoReadQuayAPITokenScope, redhatcopv1alpha1.SuperUserQuayAPITokenScope},
+						SecretName:   "quay-ecosystem-quay-api-token",
+					},
+				},
+			},
+			Status: redhatcopv1alpha1.QuayEcosystemStatus{
+				SetupComplete: true,
+			},
+		},
+		QuayHostname:                 serverURL.Host,
+		InitialQuaySuperuserUsername: constants.InitialQuaySuperuserDefaultUsername,
+		InitialQuaySuperuserPassword: constants.InitialQuaySuperuserDefaultPassword,
+	}
+}
+
+func getAPIToken(t *testing.T, qm *QuaySetupManager, name string) string {
+
+	secret := &corev1.Secret{}
+	err := qm.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: "quay-enterprise", Name: name}, secret)
+	assert.NoError(t, err)
+
+	return string(secret.Data[constants.QuayAPITokenSecretKey])
+}
+
+func TestManageAPIToken(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := newFakeQuay()
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	qm := NewQuaySetupManager(testutil.NewReconcilerBase(), nil)
+
+	quayConfiguration := newQuayConfiguration(t, server)
+
+	minted, err := qm.ManageAPIToken(quayConfiguration)
+
+	assert.NoError(t, err)
+	assert.True(t, minted)
+	assert.True(t, quay.organizations[constants.QuayAPITokenDefaultOrganization])
+	assert.Equal(t, "repo:read super:user", quay.scopes)
+	assert.Equal(t, "token-1", getAPIToken(t, qm, "quay-ecosystem-quay-api-token"))
+	assert.Equal(t, "quay-ecosystem-quay-api-token", quayConfiguration.QuayEcosystem.Status.APIToken.SecretName)
+	assert.Equal(t, quay.applications[0].ClientID, quayConfiguration.QuayEcosystem.Status.APIToken.ClientID)
+
+	// The existing token is kept
+	minted, err = qm.ManageAPIToken(quayConfiguration)
+
+	assert.NoError(t, err)
+	assert.False(t, minted)
+	assert.Equal(t, 1, quay.tokens)
+
+	// A regenerated token replaces the previous token whose application is deleted
+	quayConfiguration.QuayEcosystem.Annotations = map[string]string{constants.RegenerateTokenAnnotationKey: "1"}
+
+	minted, err = qm.ManageAPIToken(quayConfiguration)
+
+	assert.NoError(t, err)
+	assert.True(t, minted)
+	assert.Equal(t, "token-2", getAPIToken(t, qm, "quay-ecosystem-quay-api-token"))
+	assert.Len(t, quay.applications, 1)
+	assert.Equal(t, quay.applications[0].ClientID, quayConfiguration.QuayEcosystem.Status.APIToken.ClientID)
+	assert.Equal(t, "1", quayConfiguration.QuayEcosystem.Status.APIToken.RegenerationRequest)
+
+	// A token is minted for a renamed Secret and the previous Secret is removed
+	quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.SecretName = "quay-api-token"
+
+	minted, err = qm.ManageAPIToken(quayConfiguration)
+
+	assert.NoError(t, err)
+	assert.True(t, minted)
+	assert.Equal(t, "token-3", getAPIToken(t, qm, "quay-api-token"))
+
+	err = qm.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: "quay-enterprise", Name: "quay-ecosystem-quay-api-token"}, &corev1.Secret{})
+	assert.Error(t, err)
+}
+
+func TestManageAPITokenWithSuperuserSecret(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := newFakeQuay()
+	quay.username = "admin"
+	quay.password = "custom-password"
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	qm := NewQuaySetupManager(testutil.NewReconcilerBase(newSuperuserSecret(quay)), nil)
+
+	// The configuration only contains the credentials of the superuser Secret until setup has completed
+	quayConfiguration := newQuayConfiguration(t, server)
+	quayConfiguration.QuayEcosystem.Spec.Quay.SuperuserCredentialsSecretName = "quay-superuser"
+
+	minted, err := qm.ManageAPIToken(quayConfiguration)
+
+	assert.NoError(t, err)
+	assert.True(t, minted)
+	assert.Equal(t, "token-1", getAPIToken(t, qm, "quay-ecosystem-quay-api-token"))
+}
@@ -1,7 +1,9 @@
 package setup
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/utils"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -26,6 +29,9 @@ var (
 type QuaySetupManager struct {
 	reconcilerBase util.ReconcilerBase
 	k8sclient      kubernetes.Interface
+	// registrySmokeTests are the runs of the registry smoke test of each QuayEcosystem whose result has not been reported yet
+	registrySmokeTests      map[types.NamespacedName]*registrySmokeTestRun
+	registrySmokeTestsMutex sync.Mutex
 }
 
 type QuaySetupInstance struct {
@@ -34,12 +40,12 @@ type QuaySetupInstance struct {
 }
 
 func NewQuaySetupManager(reconcilerBase util.ReconcilerBase, k8sclient kubernetes.Interface) *QuaySetupManager {
-	return &QuaySetupManager{reconcilerBase: reconcilerBase, k8sclient: k8sclient}
+	return &QuaySetupManager{reconcilerBase: reconcilerBase, k8sclient: k8sclient, registrySmokeTests: map[types.NamespacedName]*registrySmokeTestRun{}}
 }
 
 func (*QuaySetupManager) NewQuaySetupInstance(quayConfiguration *resources.QuayConfiguration) (*QuaySetupInstance, error) {
 
-	httpClient := resources.GetDefaultHTTPClient()
+	httpClient := resources.GetDefaultHTTPClient(resources.GetQuayConfigAppTLSConfig(quayConfiguration))
 
 	quayConfigURL := fmt.Sprintf("https://%s", quayConfiguration.QuayConfigHostname)
 
@@ -56,18 +62,23 @@ func (*QuaySetupManager) NewQuaySetupInstance(quayConfiguration *resources.QuayC
 // SetupQuay performs the initialization and initial configuration of the Quay server
 func (qm *QuaySetupManager) SetupQuay(quaySetupInstance *QuaySetupInstance) error {
 
-	_, _, err := quaySetupInstance.setupClient.GetRegistryStatus()
+	_, _, err := quaySetupInstance.setupClient.GetRegistryStatus(context.TODO())
 
 	if err != nil {
 		logging.Log.Error(err, "Failed to obtain initial registry status")
-		return err
+
+		if client.IsUnauthorized(err) {
+			return fmt.Errorf("Quay config app rejected the credentials of user %s: %s", quaySetupInstance.quayConfiguration.QuayConfigUsername, err.Error())
+		}
+
+		return fmt.Errorf("Failed to obtain initial registry status: %s", resources.WrapTLSVerificationError(err).Error())
 	}
 
-	_, _, err = quaySetupInstance.setupClient.InitializationConfiguration()
+	_, _, err = quaySetupInstance.setupClient.InitializationConfiguration(context.TODO())
 
 	if err != nil {
 		logging.Log.Error(err, "Failed to Initialize")
-		return err
+		return fmt.Errorf("Failed to Initialize: %s", err.Error())
 	}
 
 	quayConfig := client.QuayConfig{
@@ -104,21 +115,25 @@ func (qm *QuaySetupManager) SetupQuay(quaySetupInstance *QuaySetupInstance) erro
 		quayConfig.Config["REPO_MIRROR_SERVER_HOSTNAME"] = quaySetupInstance.quayConfiguration.QuayEcosystem.Spec.Quay.RepoMirrorServerHostname
 	}
 
-	_, _, err = quaySetupInstance.setupClient.UpdateQuayConfiguration(quayConfig)
+	_, _, err = quaySetupInstance.setupClient.UpdateQuayConfiguration(context.TODO(), quayConfig)
 
 	if err != nil {
 		logging.Log.Error(err, "Failed to update quay configuration")
 		return fmt.Errorf("Failed to update quay configuration: %s", err.Error())
 	}
 
-	_, _, err = quaySetupInstance.setupClient.SetupDatabase()
+	// The migrations of the database take longer than the default timeout of requests
+	setupDatabaseCtx, cancel := context.WithTimeout(context.TODO(), constants.QuaySetupDatabaseTimeout)
+	defer cancel()
+
+	_, _, err = quaySetupInstance.setupClient.SetupDatabase(setupDatabaseCtx)
 
 	if err != nil {
 		logging.Log.Error(err, "Failed to setup database")
 		return fmt.Errorf("Failed to setup database: %s", err.Error())
 	}
 
-	_, _, err = quaySetupInstance.setupClient.CreateSuperuser(client.QuayCreateSuperuserRequest{
+	_, _, err = quaySetupInstance.setupClient.CreateSuperuser(context.TODO(), client.QuayCreateSuperuserRequest{
 		Username:        quaySetupInstance.quayConfiguration.InitialQuaySuperuserUsername,
 		Email:           quaySetupInstance.quayConfiguration.InitialQuaySuperuserEmail,
 		Password:        quaySetupInstance.quayConfiguration.InitialQuaySuperuserPassword,
@@ -130,7 +145,7 @@ func (qm *QuaySetupManager) SetupQuay(quaySetupInstance *QuaySetupInstance) erro
 		return fmt.Errorf("Failed to create superuser: %s", err.Error())
 	}
 
-	_, quayConfig, err = quaySetupInstance.setupClient.GetQuayConfiguration()
+	_, quayConfig, err = quaySetupInstance.setupClient.GetQuayConfiguration(context.TODO())
 
 	if err != nil {
 		logging.Log.Error(err, "Failed to get Quay Configuration")
@@ -212,17 +227,18 @@ func (qm *QuaySetupManager) SetupQuay(quaySetupInstance *QuaySetupInstance) erro
 
 	// Add Certificates
 	if !quaySetupInstance.quayConfiguration.QuayEcosystem.IsInsecureQuay() {
-		_, _, err = quaySetupInstance.setupClient.UploadFileResource(constants.QuayAppConfigSSLPrivateKeySecretKey, quaySetupInstance.quayConfiguration.QuaySslPrivateKey)
+		_, _, err = quaySetupInstance.setupClient.UploadFileResource(context.TODO(), constants.QuayAppConfigSSLPrivateKeySecretKey, quaySetupInstance.quayConfiguration.QuaySslPrivateKey)
 
 		if err != nil {
 			logging.Log.Error(err, "Failed to upload SSL certificates")
 			return fmt.Errorf("Failed to upload SSL certificates: %s", err.Error())
 		}
 
-		_, _, err = quaySetupInstance.setupClient.UploadFileResource(constants.QuayAppConfigSSLCertificateSecretKey, quaySetupInstance.quayConfiguration.QuaySslCertificate)
+		_, _, err = quaySetupInstance.setupClient.UploadFileResource(context.TODO(), constants.QuayAppConfigSSLCertificateSecretKey, quaySetupInstance.quayConfiguration.QuaySslCertificate)
 
 		if err != nil {
-			return err
+			logging.Log.Error(err, "Failed to upload SSL certificates")
+			return fmt.Errorf("Failed to upload SSL certificates: %s", err.Error())
 		}
 	}
 
@@ -256,14 +272,14 @@ func (qm *QuaySetupManager) SetupQuay(quaySetupInstance *QuaySetupInstance) erro
 	}
 
 	quayConfig.Config["SETUP_COMPLETE"] = true
-	_, quayConfig, err = quaySetupInstance.setupClient.UpdateQuayConfiguration(quayConfig)
+	_, quayConfig, err = quaySetupInstance.setupClient.UpdateQuayConfiguration(context.TODO(), quayConfig)
 
 	if err != nil {
 		logging.Log.Error(err, "Failed to update Quay Configuration")
 		return fmt.Errorf("Failed to update Quay Configuration: %s", err.Error())
 	}
 
-	_, _, err = quaySetupInstance.setupClient.CompleteSetup()
+	_, _, err = quaySetupInstance.setupClient.CompleteSetup(context.TODO())
 
 	if err != nil {
 		logging.Log.Error(err, "Failed to complete Quay Configuration setup")
@@ -276,10 +292,10 @@ func (qm *QuaySetupManager) SetupQuay(quaySetupInstance *QuaySetupInstance) erro
 
 func (*QuaySetupManager) validateComponent(quaySetupInstance *QuaySetupInstance, quayConfig client.QuayConfig, validationType client.QuayValidationType) error {
 
-	_, validateResponse, err := quaySetupInstance.setupClient.ValidateComponent(quayConfig, validationType)
+	_, validateResponse, err := quaySetupInstance.setupClient.ValidateComponent(context.TODO(), quayConfig, validationType)
 
 	if err != nil {
-		return err
+		return fmt.Errorf("%s Validation Failed: %s", validationType, err.Error())
 	}
 
 	if !validateResponse.Status {
@@ -0,0 +1,250 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	"github.com/redhat-cop/quay-operator/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// registrySmokeTestRun is a run of the registry smoke test in the background
+type registrySmokeTestRun struct {
+	// generation is the generation of the QuayEcosystem the run was started for
+	generation   int64
+	organization string
+	// done is closed once the run has finished
+	done     chan struct{}
+	digest   string
+	err      error
+	duration time.Duration
+	finished metav1.Time
+}
+
+// ManageRegistrySmokeTest runs the registry smoke test when it is due and reports its result in the RegistryFunctional condition of the QuayEcosystem
+// The smoke test runs once Quay is available, whenever the QuayEcosystem changes and then at the configured interval. Returns the duration until the next run
+// The smoke test runs in the background so that it does not hold up the reconciliation, its result is reported by the first reconciliation after it finishes
+func (qm *QuaySetupManager) ManageRegistrySmokeTest(quayConfiguration *resources.QuayConfiguration) time.Duration {
+
+	quayEcosystem := quayConfiguration.QuayEcosystem
+	key := types.NamespacedName{Namespace: quayEcosystem.Namespace, Name: quayEcosystem.Name}
+
+	qm.registrySmokeTestsMutex.Lock()
+	defer qm.registrySmokeTestsMutex.Unlock()
+
+	if run, found := qm.registrySmokeTests[key]; found {
+
+		select {
+		case <-run.done:
+			delete(qm.registrySmokeTests, key)
+			qm.reportRegistrySmokeTest(quayEcosystem, run)
+		default:
+			return constants.RegistrySmokeTestPollInterval
+		}
+	}
+
+	if !quayEcosystem.IsConditionTrue(redhatcopv1alpha1.QuayEcosystemAvailableCondition) {
+
+		// The result of a previous run remains until the smoke test can run again
+		if _, found := quayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition); !found {
+			quayEcosystem.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+				Type:    redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition,
+				Reason:  string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestPending),
+				Message: "Waiting for Quay to become available",
+				Status:  corev1.ConditionUnknown,
+			})
+		}
+
+		return constants.RegistrySmokeTestRetryInterval
+	}
+
+	if wait := nextRegistrySmokeTest(quayEcosystem, quayConfiguration.RegistrySmokeTestInterval, time.Now()); wait > 0 {
+		return wait
+	}
+
+	logging.Log.Info("Running registry smoke test", "Namespace", quayEcosystem.Namespace, "Name", quayEcosystem.Name, "Organization", quayEcosystem.Spec.Quay.SmokeTest.Organization)
+
+	if _, found := quayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition); !found {
+		quayEcosystem.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+			Type:    redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition,
+			Reason:  string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestPending),
+			Message: "Waiting for the registry smoke test to complete",
+			Status:  corev1.ConditionUnknown,
+		})
+	}
+
+	run := &registrySmokeTestRun{generation: quayEcosystem.Generation, organization: quayEcosystem.Spec.Quay.SmokeTest.Organization, done: make(chan struct{})}
+	qm.registrySmokeTests[key] = run
+
+	// The QuayEcosystem keeps being reconciled while the smoke test runs
+	runConfiguration := *quayConfiguration
+	runConfiguration.QuayEcosystem = quayEcosystem.DeepCopy()
+
+	go func() {
+		defer close(run.done)
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.RegistrySmokeTestTimeout)
+		defer cancel()
+
+		start := time.Now()
+		run.digest, run.err = qm.runRegistrySmokeTest(ctx, &runConfiguration)
+		run.duration = time.Since(start)
+		run.finished = metav1.Now()
+	}()
+
+	return constants.RegistrySmokeTestPollInterval
+}
+
+// reportRegistrySmokeTest reports the result of a finished run of the registry smoke test in the status of the QuayEcosystem
+func (qm *QuaySetupManager) reportRegistrySmokeTest(quayEcosystem *redhatcopv1alpha1.QuayEcosystem, run *registrySmokeTestRun) {
+
+	quayEcosystem.Status.SmokeTest = &redhatcopv1alpha1.RegistrySmokeTestStatus{
+		LastRunTime:        &run.finished,
+		ObservedGeneration: run.generation,
+	}
+
+	if run.err != nil {
+		qm.reconcilerBase.GetRecorder().Event(quayEcosystem, "Warning", "Registry Smoke Test Failed", run.err.Error())
+
+		quayEcosystem.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+			Type:    redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition,
+			Reason:  string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestFailure),
+			Message: run.err.Error(),
+			Status:  corev1.ConditionFalse,
+		})
+	} else {
+		quayEcosystem.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+			Type:    redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition,
+			Reason:  string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestSuccess),
+			Message: fmt.Sprintf("Pushed, pulled and deleted image %s/%s@%s in %s", run.organization, constants.RegistrySmokeTestRepository, run.digest, run.duration.Round(time.Millisecond)),
+			Status:  corev1.ConditionTrue,
+		})
+	}
+}
+
+// nextRegistrySmokeTest returns the duration until the smoke test is due, which is immediately when it has not run for the current generation of the QuayEcosystem
+// A failed smoke test runs again after the retry interval when it is shorter than the configured interval
+func nextRegistrySmokeTest(quayEcosystem *redhatcopv1alpha1.QuayEcosystem, interval time.Duration, now time.Time) time.Duration {
+
+	smokeTestStatus := quayEcosystem.Status.SmokeTest
+
+	if smokeTestStatus == nil || smokeTestStatus.LastRunTime == nil || smokeTestStatus.ObservedGeneration != quayEcosystem.Generation {
+		return 0
+	}
+
+	if !quayEcosystem.IsConditionTrue(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition) && constants.RegistrySmokeTestRetryInterval < interval {
+		interval = constants.RegistrySmokeTestRetryInterval
+	}
+
+	wait := smokeTestStatus.LastRunTime.Add(interval).Sub(now)
+
+	if wait < 0 {
+		return 0
+	}
+
+	return wait
+}
+
+// runRegistrySmokeTest pushes a scratch image to the organization of the smoke test as the initial superuser, pulls it back and deletes it
+// Returns the digest of the manifest of the image
+func (qm *QuaySetupManager) runRegistrySmokeTest(ctx context.Context, quayConfiguration *resources.QuayConfiguration) (string, error) {
+
+	quayEcosystem := quayConfiguration.QuayEcosystem
+	organization := quayEcosystem.Spec.Quay.SmokeTest.Organization
+	repository := fmt.Sprintf("%s/%s", organization, constants.RegistrySmokeTestRepository)
+	tag := constants.RegistrySmokeTestTag
+
+	// The credentials of the configuration are only read from the superuser Secret until setup has completed
+	username, password, err := quayapi.GetSuperuserCredentials(qm.reconcilerBase.GetClient(), quayEcosystem)
+
+	if err != nil {
+		return "", err
+	}
+
+	tlsConfig := resources.GetQuayConfigurationTLSConfig(quayConfiguration, quayConfiguration.QuayHostname)
+	quayClient := quayapi.NewQuayClient(quayEcosystem, quayConfiguration.QuayHostname, username, password, tlsConfig)
+	registryClient := registry.NewClient(resources.GetDefaultHTTPClient(tlsConfig), quayClient.BaseURL.String(), username, password)
+
+	// Quay does not accept basic authentication for the management of organizations and repositories
+	_, quayClient, err = quayClient.SignIn(ctx)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to sign in to Quay: %s", resources.WrapTLSVerificationError(err).Error())
+	}
+
+	if err := ensureOrganization(ctx, quayClient, organization); err != nil {
+		return "", fmt.Errorf("Failed to create organization %s: %s", organization, err.Error())
+	}
+
+	// The content is unique to each run so that the layer is written to the storage of the registry
+	image, err := registry.NewScratchImage(map[string][]byte{
+		"smoke-test": []byte(fmt.Sprintf("%s/%s %s", quayEcosystem.Namespace, quayEcosystem.Name, time.Now().UTC().Format(time.RFC3339Nano))),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	// The repository created by the push is removed even when the smoke test fails part way
+	defer func() {
+		_, err := quayClient.DeleteRepository(context.TODO(), organization, constants.RegistrySmokeTestRepository)
+
+		if err != nil && !client.IsNotFound(err) {
+			logging.Log.Error(err, "Failed to delete registry smoke test repository", "Namespace", quayEcosystem.Namespace, "Name", quayEcosystem.Name, "Repository", repository)
+		}
+	}()
+
+	digest, err := registryClient.PushImage(ctx, repository, tag, image)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to push image %s:%s: %s", repository, tag, err.Error())
+	}
+
+	tags, err := registryClient.GetTags(ctx, repository)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to list tags of repository %s: %s", repository, err.Error())
+	}
+
+	if !containsTag(tags, tag) {
+		return "", fmt.Errorf("Tag %s of repository %s is not listed after it was pushed", tag, repository)
+	}
+
+	pulledImage, pulledDigest, err := registryClient.PullImage(ctx, repository, tag)
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to pull image %s:%s: %s", repository, tag, err.Error())
+	}
+
+	if pulledDigest != digest || !reflect.DeepEqual(pulledImage, image) {
+		return "", fmt.Errorf("Image %s:%s pulled from the registry differs from the image pushed", repository, tag)
+	}
+
+	if err := registryClient.DeleteManifest(ctx, repository, digest); err != nil {
+		return "", fmt.Errorf("Failed to delete image %s@%s: %s", repository, digest, err.Error())
+	}
+
+	return digest, nil
+}
+
+// containsTag determines whether the tag is part of the tags
+func containsTag(tags []string, tag string) bool {
+
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
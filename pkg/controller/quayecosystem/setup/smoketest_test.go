@@ -0,0 +1,310 @@
+package setup
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	"github.com/redhat-cop/quay-operator/pkg/registry"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// fakeRegistry is the state held by the registry endpoints of the fake Quay server
+type fakeRegistry struct {
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	// tags maps the tags of the smoke test repository to the digest of their manifest
+	tags                map[string]string
+	pushes              int
+	deletedRepositories []string
+	// rejectManifests causes the registry to fail pushes of manifests
+	rejectManifests bool
+}
+
+// newRegistryServer returns a server implementing the registry and repository deletion endpoints of Quay for the smoke test repository along with the endpoints of the fake Quay server
+func newRegistryServer(quay *fakeQuay, quayRegistry *fakeRegistry) *httptest.Server {
+
+	quayHandler := newQuayHandler(quay)
+	repositoryPath := "/v2/" + constants.RegistrySmokeTestDefaultOrganization + "/" + constants.RegistrySmokeTestRepository + "/"
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/v1/repository/") && r.Method == http.MethodDelete:
+			if !isSignedIn(r) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			quayRegistry.deletedRepositories = append(quayRegistry.deletedRepositories, strings.TrimPrefix(r.URL.Path, "/api/v1/repository/"))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case !strings.HasPrefix(r.URL.Path, "/v2/"):
+			quayHandler(w, r)
+			return
+		}
+
+		if username, password, ok := r.BasicAuth(); !ok || username != quay.username || password != quay.password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="quay"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if !strings.HasPrefix(r.URL.Path, repositoryPath) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		path := strings.TrimPrefix(r.URL.Path, repositoryPath)
+
+		switch {
+		case path == "blobs/uploads/" && r.Method == http.MethodPost:
+			w.Header().Set("Location", repositoryPath+"blobs/uploads/upload")
+			w.WriteHeader(http.StatusAccepted)
+		case strings.HasPrefix(path, "blobs/uploads/") && r.Method == http.MethodPut:
+			content, _ := ioutil.ReadAll(r.Body)
+			quayRegistry.blobs[r.URL.Query().Get("digest")] = content
+			w.WriteHeader(http.StatusCreated)
+		case strings.HasPrefix(path, "blobs/"):
+			w.Write(quayRegistry.blobs[strings.TrimPrefix(path, "blobs/")])
+		case path == "tags/list":
+			tagList := registry.TagList{Tags: []string{}}
+			for tag := range quayRegistry.tags {
+				tagList.Tags = append(tagList.Tags, tag)
+			}
+			json.NewEncoder(w).Encode(tagList)
+		case strings.HasPrefix(path, "manifests/"):
+			reference := strings.TrimPrefix(path, "manifests/")
+
+			switch r.Method {
+			case http.MethodPut:
+				if quayRegistry.rejectManifests {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(`{"errors": [{"code": "UNKNOWN", "message": "storage unavailable"}]}`))
+					return
+				}
+				content, _ := ioutil.ReadAll(r.Body)
+				digest := registry.Digest(content)
+				quayRegistry.manifests[digest] = content
+				quayRegistry.tags[reference] = digest
+				quayRegistry.pushes++
+				w.Header().Set("Docker-Content-Digest", digest)
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodGet:
+				w.Write(quayRegistry.manifests[quayRegistry.tags[reference]])
+			case http.MethodDelete:
+				delete(quayRegistry.manifests, reference)
+				for tag, digest := range quayRegistry.tags {
+					if digest == reference {
+						delete(quayRegistry.tags, tag)
+					}
+				}
+				w.WriteHeader(http.StatusAccepted)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newSmokeTestConfiguration(t *testing.T, server *httptest.Server) *resources.QuayConfiguration {
+
+	quayConfiguration := newQuayConfiguration(t, server)
+	quayConfiguration.QuayEcosystem.Generation = 1
+	quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest = &redhatcopv1alpha1.RegistrySmokeTest{
+		Organization: constants.RegistrySmokeTestDefaultOrganization,
+	}
+	quayConfiguration.QuayEcosystem.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:   redhatcopv1alpha1.QuayEcosystemAvailableCondition,
+		Status: corev1.ConditionTrue,
+	})
+	quayConfiguration.RegistrySmokeTestInterval = time.Hour
+
+	return quayConfiguration
+}
+
+// waitForRegistrySmokeTest waits for the run of the registry smoke test of the QuayEcosystem to finish
+func waitForRegistrySmokeTest(t *testing.T, qm *QuaySetupManager, quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+
+	qm.registrySmokeTestsMutex.Lock()
+	run, found := qm.registrySmokeTests[types.NamespacedName{Namespace: quayEcosystem.Namespace, Name: quayEcosystem.Name}]
+	qm.registrySmokeTestsMutex.Unlock()
+
+	if !assert.True(t, found, "No registry smoke test is running") {
+		return
+	}
+
+	select {
+	case <-run.done:
+	case <-time.After(constants.RegistrySmokeTestTimeout):
+		t.Fatal("Registry smoke test did not finish")
+	}
+}
+
+func TestManageRegistrySmokeTest(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := newFakeQuay()
+	quayRegistry := &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}, tags: map[string]string{}}
+	server := newRegistryServer(quay, quayRegistry)
+	defer server.Close()
+
+	qm := NewQuaySetupManager(testutil.NewReconcilerBase(), nil)
+	quayConfiguration := newSmokeTestConfiguration(t, server)
+	quayEcosystem := quayConfiguration.QuayEcosystem
+
+	// The smoke test runs in the background and its result is reported once it has finished
+	wait := qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	condition, found := quayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition)
+
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionUnknown, condition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestPending), condition.Reason)
+	assert.Equal(t, constants.RegistrySmokeTestPollInterval, wait)
+
+	waitForRegistrySmokeTest(t, qm, quayEcosystem)
+
+	wait = qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	condition, found = quayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition)
+
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestSuccess), condition.Reason)
+	assert.True(t, wait > 59*time.Minute && wait <= time.Hour)
+	assert.True(t, quay.organizations[constants.RegistrySmokeTestDefaultOrganization])
+	assert.Equal(t, 1, quayRegistry.pushes)
+	assert.Empty(t, quayRegistry.manifests)
+	assert.Equal(t, []string{constants.RegistrySmokeTestDefaultOrganization + "/" + constants.RegistrySmokeTestRepository}, quayRegistry.deletedRepositories)
+	assert.Equal(t, int64(1), quayEcosystem.Status.SmokeTest.ObservedGeneration)
+
+	// The smoke test does not run again before its interval has elapsed
+	wait = qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	assert.True(t, wait > 59*time.Minute && wait <= time.Hour)
+	assert.Equal(t, 1, quayRegistry.pushes)
+
+	// A failed smoke test runs again after the retry interval
+	quayEcosystem.Generation = 2
+	quayRegistry.rejectManifests = true
+
+	wait = qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	assert.Equal(t, constants.RegistrySmokeTestPollInterval, wait)
+
+	waitForRegistrySmokeTest(t, qm, quayEcosystem)
+
+	wait = qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	condition, _ = quayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition)
+
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestFailure), condition.Reason)
+	assert.Contains(t, condition.Message, "Failed to push image quay-operator-smoke-test/smoke-test:latest")
+	assert.Contains(t, condition.Message, "UNKNOWN: storage unavailable")
+	assert.True(t, wait > 0 && wait <= constants.RegistrySmokeTestRetryInterval)
+	assert.Len(t, quayRegistry.deletedRepositories, 2)
+}
+
+func TestRegistrySmokeTestWaitsForQuay(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	qm := NewQuaySetupManager(testutil.NewReconcilerBase(), nil)
+	quayConfiguration := newSmokeTestConfiguration(t, server)
+	quayConfiguration.QuayEcosystem.Status.Conditions = nil
+
+	wait := qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	condition, found := quayConfiguration.QuayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition)
+
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionUnknown, condition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayEcosystemRegistrySmokeTestPending), condition.Reason)
+	assert.Equal(t, constants.RegistrySmokeTestRetryInterval, wait)
+	assert.Nil(t, quayConfiguration.QuayEcosystem.Status.SmokeTest)
+}
+
+func TestRegistrySmokeTestRunsInBackground(t *testing.T) {
+	testutil.SetupLogging()
+
+	release := make(chan struct{})
+	requests := make(chan struct{}, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case requests <- struct{}{}:
+		default:
+		}
+		<-release
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	qm := NewQuaySetupManager(testutil.NewReconcilerBase(), nil)
+	quayConfiguration := newSmokeTestConfiguration(t, server)
+	quayEcosystem := quayConfiguration.QuayEcosystem
+
+	assert.Equal(t, constants.RegistrySmokeTestPollInterval, qm.ManageRegistrySmokeTest(quayConfiguration))
+
+	// The reconciliation is not held up while Quay does not respond and no other run is started
+	<-requests
+
+	assert.Equal(t, constants.RegistrySmokeTestPollInterval, qm.ManageRegistrySmokeTest(quayConfiguration))
+	assert.Nil(t, quayEcosystem.Status.SmokeTest)
+	assert.Len(t, qm.registrySmokeTests, 1)
+
+	close(release)
+	waitForRegistrySmokeTest(t, qm, quayEcosystem)
+
+	wait := qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	condition, found := quayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition)
+
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Contains(t, condition.Message, "Failed to sign in to Quay")
+	assert.True(t, wait > 0 && wait <= constants.RegistrySmokeTestRetryInterval)
+	assert.Empty(t, qm.registrySmokeTests)
+}
+
+func TestRegistrySmokeTestWithSuperuserSecret(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := newFakeQuay()
+	quay.username = "admin"
+	quay.password = "custom-password"
+	quayRegistry := &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}, tags: map[string]string{}}
+	server := newRegistryServer(quay, quayRegistry)
+	defer server.Close()
+
+	qm := NewQuaySetupManager(testutil.NewReconcilerBase(newSuperuserSecret(quay)), nil)
+
+	// The configuration only contains the credentials of the superuser Secret until setup has completed
+	quayConfiguration := newSmokeTestConfiguration(t, server)
+	quayConfiguration.QuayEcosystem.Spec.Quay.SuperuserCredentialsSecretName = "quay-superuser"
+
+	qm.ManageRegistrySmokeTest(quayConfiguration)
+	waitForRegistrySmokeTest(t, qm, quayConfiguration.QuayEcosystem)
+	qm.ManageRegistrySmokeTest(quayConfiguration)
+
+	condition, found := quayConfiguration.QuayEcosystem.FindConditionByType(redhatcopv1alpha1.QuayEcosystemRegistryFunctionalCondition)
+
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status, condition.Message)
+	assert.Equal(t, 1, quayRegistry.pushes)
+	assert.Len(t, quayRegistry.deletedRepositories, 1)
+}
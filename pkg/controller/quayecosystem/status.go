@@ -0,0 +1,167 @@
+package quayecosystem
+
+import (
+	"context"
+	"fmt"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// getComponentsStatus builds the status of each component from the Deployments managed by the operator
+func (r *ReconcileQuayEcosystem) getComponentsStatus(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) *redhatcopv1alpha1.QuayEcosystemComponentsStatus {
+
+	return &redhatcopv1alpha1.QuayEcosystemComponentsStatus{
+		Quay:          r.getComponentStatus(quayEcosystem.Namespace, resources.GetQuayResourcesName(quayEcosystem)),
+		QuayConfig:    r.getComponentStatus(quayEcosystem.Namespace, resources.GetQuayConfigResourcesName(quayEcosystem)),
+		RepoMirror:    r.getComponentStatus(quayEcosystem.Namespace, resources.GetQuayRepoMirrorResourcesName(quayEcosystem)),
+		Redis:         r.getComponentStatus(quayEcosystem.Namespace, resources.GetRedisResourcesName(quayEcosystem)),
+		QuayDatabase:  r.getComponentStatus(quayEcosystem.Namespace, resources.GetDatabaseResourceName(quayEcosystem, constants.DatabaseComponentQuay)),
+		Clair:         r.getComponentStatus(quayEcosystem.Namespace, resources.GetClairResourcesName(quayEcosystem)),
+		ClairDatabase: r.getComponentStatus(quayEcosystem.Namespace, resources.GetDatabaseResourceName(quayEcosystem, constants.DatabaseComponentClair)),
+	}
+}
+
+// getComponentStatus returns the status of a single component or nil when the component has not been deployed
+func (r *ReconcileQuayEcosystem) getComponentStatus(namespace string, name string) *redhatcopv1alpha1.ComponentStatus {
+
+	deployment := &appsv1.Deployment{}
+	err := r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, deployment)
+
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logging.Log.Error(err, "Failed to retrieve Deployment for component status", "Namespace", namespace, "Name", name)
+			return &redhatcopv1alpha1.ComponentStatus{
+				LastError: err.Error(),
+			}
+		}
+		return nil
+	}
+
+	componentStatus := &redhatcopv1alpha1.ComponentStatus{
+		ReadyReplicas: deployment.Status.ReadyReplicas,
+		LastError:     getDeploymentError(deployment),
+	}
+
+	if deployment.Spec.Replicas != nil {
+		componentStatus.DesiredReplicas = *deployment.Spec.Replicas
+	}
+
+	if len(deployment.Spec.Template.Spec.Containers) > 0 {
+		componentStatus.Image = deployment.Spec.Template.Spec.Containers[0].Image
+	}
+
+	service := &corev1.Service{}
+	err = r.reconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, service)
+
+	if err == nil && len(service.Spec.Ports) > 0 {
+		componentStatus.Endpoint = fmt.Sprintf("%s.%s.svc:%d", service.Name, service.Namespace, service.Spec.Ports[0].Port)
+	}
+
+	return componentStatus
+}
+
+// getDeploymentError returns the message of the first failing condition of the Deployment
+func getDeploymentError(deployment *appsv1.Deployment) string {
+
+	for _, condition := range deployment.Status.Conditions {
+
+		if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
+			return condition.Message
+		}
+
+		if (condition.Type == appsv1.DeploymentProgressing || condition.Type == appsv1.DeploymentAvailable) && condition.Status == corev1.ConditionFalse {
+			return condition.Message
+		}
+	}
+
+	return ""
+}
+
+// getScaleStatus returns the number of ready Quay replicas and the selector of the Quay pods for the scale subresource
+func getScaleStatus(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) (int32, string) {
+
+	selector := labels.SelectorFromSet(resources.BuildQuayResourceLabels(resources.BuildResourceLabels(quayEcosystem))).String()
+
+	if quayEcosystem.Status.Components == nil || quayEcosystem.Status.Components.Quay == nil {
+		return 0, selector
+	}
+
+	return quayEcosystem.Status.Components.Quay.ReadyReplicas, selector
+}
+
+// getAvailableCondition determines whether all desired replicas of Quay are ready
+func getAvailableCondition(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) redhatcopv1alpha1.QuayEcosystemCondition {
+
+	condition := redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:    redhatcopv1alpha1.QuayEcosystemAvailableCondition,
+		Reason:  string(redhatcopv1alpha1.QuayEcosystemQuayUnavailable),
+		Message: "Quay has not been deployed",
+		Status:  corev1.ConditionFalse,
+	}
+
+	if quayEcosystem.Status.Components == nil || quayEcosystem.Status.Components.Quay == nil {
+		return condition
+	}
+
+	quayStatus := quayEcosystem.Status.Components.Quay
+
+	condition.Message = fmt.Sprintf("%d of %d Quay replicas are ready", quayStatus.ReadyReplicas, quayStatus.DesiredReplicas)
+
+	if quayStatus.DesiredReplicas > 0 && quayStatus.ReadyReplicas >= quayStatus.DesiredReplicas {
+		condition.Reason = string(redhatcopv1alpha1.QuayEcosystemQuayAvailable)
+		condition.Status = corev1.ConditionTrue
+	}
+
+	return condition
+}
+
+// getSetupCompleteCondition reflects whether the initial Quay setup process has completed
+func getSetupCompleteCondition(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) redhatcopv1alpha1.QuayEcosystemCondition {
+
+	if quayEcosystem.Status.SetupComplete {
+		return redhatcopv1alpha1.QuayEcosystemCondition{
+			Type:    redhatcopv1alpha1.QuayEcosystemSetupCompleteCondition,
+			Reason:  string(redhatcopv1alpha1.QuayEcosystemQuaySetupSuccess),
+			Message: "Setup Completed Successfully",
+			Status:  corev1.ConditionTrue,
+		}
+	}
+
+	if quayEcosystem.Spec.Quay != nil && quayEcosystem.Spec.Quay.SkipSetup {
+		return redhatcopv1alpha1.QuayEcosystemCondition{
+			Type:    redhatcopv1alpha1.QuayEcosystemSetupCompleteCondition,
+			Reason:  string(redhatcopv1alpha1.QuayEcosystemQuaySetupSkipped),
+			Message: "Setup has been skipped",
+			Status:  corev1.ConditionFalse,
+		}
+	}
+
+	return redhatcopv1alpha1.QuayEcosystemCondition{
+		Type:    redhatcopv1alpha1.QuayEcosystemSetupCompleteCondition,
+		Reason:  string(redhatcopv1alpha1.QuayEcosystemQuaySetupPending),
+		Message: "Setup has not yet completed",
+		Status:  corev1.ConditionFalse,
+	}
+}
+
+// getPhase summarizes the conditions of the QuayEcosystem
+func getPhase(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) redhatcopv1alpha1.QuayEcosystemPhase {
+
+	if quayEcosystem.IsConditionTrue(redhatcopv1alpha1.QuayEcosystemDegradedCondition) {
+		return redhatcopv1alpha1.QuayEcosystemPhaseFailed
+	}
+
+	if quayEcosystem.IsConditionTrue(redhatcopv1alpha1.QuayEcosystemAvailableCondition) && !quayEcosystem.IsConditionTrue(redhatcopv1alpha1.QuayEcosystemProgressingCondition) {
+		return redhatcopv1alpha1.QuayEcosystemPhaseRunning
+	}
+
+	return redhatcopv1alpha1.QuayEcosystemPhaseProvisioning
+}
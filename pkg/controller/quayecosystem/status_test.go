@@ -0,0 +1,178 @@
+package quayecosystem
+
+import (
+	"testing"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/setup"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestComponentsStatus(t *testing.T) {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	replicas := int32(2)
+
+	quayDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quay-operator-quay",
+			Namespace: namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "quay",
+							Image: constants.QuayImage,
+						},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:    appsv1.DeploymentProgressing,
+					Status:  corev1.ConditionFalse,
+					Message: "ReplicaSet has timed out progressing.",
+				},
+			},
+		},
+	}
+
+	quayService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quay-operator-quay",
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Port: 443,
+				},
+			},
+		},
+	}
+
+	// Objects to track in the fake client.
+	objs := []runtime.Object{
+		quayEcosystem,
+		quayDeployment,
+		quayService,
+	}
+	// Register operator types with the runtime scheme.
+	s := scheme.Scheme
+	s.AddKnownTypes(redhatcopv1alpha1.SchemeGroupVersion, quayEcosystem)
+	// Initialize fake client
+	cl := fake.NewFakeClientWithScheme(s, objs...)
+
+	reconcilerBase := util.NewReconcilerBase(cl, s, nil, nil)
+	r := &ReconcileQuayEcosystem{reconcilerBase: reconcilerBase, k8sclient: nil, quaySetupManager: setup.NewQuaySetupManager(reconcilerBase, nil), isOpenShift: true}
+
+	componentsStatus := r.getComponentsStatus(quayEcosystem)
+
+	assert.NotNil(t, componentsStatus.Quay)
+	assert.Equal(t, int32(2), componentsStatus.Quay.DesiredReplicas)
+	assert.Equal(t, int32(1), componentsStatus.Quay.ReadyReplicas)
+	assert.Equal(t, constants.QuayImage, componentsStatus.Quay.Image)
+	assert.Equal(t, "quay-operator-quay.quay-enterprise.svc:443", componentsStatus.Quay.Endpoint)
+	assert.Equal(t, "ReplicaSet has timed out progressing.", componentsStatus.Quay.LastError)
+
+	// Components which have not been deployed are not reported
+	assert.Nil(t, componentsStatus.Redis)
+	assert.Nil(t, componentsStatus.Clair)
+}
+
+func TestPhase(t *testing.T) {
+
+	cases := []struct {
+		componentsStatus *redhatcopv1alpha1.QuayEcosystemComponentsStatus
+		degraded         corev1.ConditionStatus
+		progressing      corev1.ConditionStatus
+		expected         redhatcopv1alpha1.QuayEcosystemPhase
+	}{
+		{
+			componentsStatus: nil,
+			degraded:         corev1.ConditionFalse,
+			progressing:      corev1.ConditionTrue,
+			expected:         redhatcopv1alpha1.QuayEcosystemPhaseProvisioning,
+		},
+		{
+			componentsStatus: &redhatcopv1alpha1.QuayEcosystemComponentsStatus{
+				Quay: &redhatcopv1alpha1.ComponentStatus{DesiredReplicas: 1, ReadyReplicas: 1},
+			},
+			degraded:    corev1.ConditionFalse,
+			progressing: corev1.ConditionFalse,
+			expected:    redhatcopv1alpha1.QuayEcosystemPhaseRunning,
+		},
+		{
+			componentsStatus: &redhatcopv1alpha1.QuayEcosystemComponentsStatus{
+				Quay: &redhatcopv1alpha1.ComponentStatus{DesiredReplicas: 2, ReadyReplicas: 1},
+			},
+			degraded:    corev1.ConditionFalse,
+			progressing: corev1.ConditionFalse,
+			expected:    redhatcopv1alpha1.QuayEcosystemPhaseProvisioning,
+		},
+		{
+			componentsStatus: &redhatcopv1alpha1.QuayEcosystemComponentsStatus{
+				Quay: &redhatcopv1alpha1.ComponentStatus{DesiredReplicas: 1, ReadyReplicas: 1},
+			},
+			degraded:    corev1.ConditionTrue,
+			progressing: corev1.ConditionFalse,
+			expected:    redhatcopv1alpha1.QuayEcosystemPhaseFailed,
+		},
+	}
+
+	for i, c := range cases {
+
+		quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{}
+		quayEcosystem.Status.Components = c.componentsStatus
+		quayEcosystem.SetCondition(getAvailableCondition(quayEcosystem))
+		quayEcosystem.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{Type: redhatcopv1alpha1.QuayEcosystemDegradedCondition, Status: c.degraded})
+		quayEcosystem.SetCondition(redhatcopv1alpha1.QuayEcosystemCondition{Type: redhatcopv1alpha1.QuayEcosystemProgressingCondition, Status: c.progressing})
+
+		result := getPhase(quayEcosystem)
+
+		if c.expected != result {
+			t.Errorf("Test case %d did not match\nExpected: %#v\nActual: %#v", i, c.expected, result)
+		}
+	}
+}
+
+func TestScaleStatus(t *testing.T) {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	replicas, selector := getScaleStatus(quayEcosystem)
+	assert.Equal(t, int32(0), replicas)
+	assert.Equal(t, "app=quay-operator,quay-enterprise-component=app,quay-enterprise-cr=quay-operator", selector)
+
+	quayEcosystem.Status.Components = &redhatcopv1alpha1.QuayEcosystemComponentsStatus{
+		Quay: &redhatcopv1alpha1.ComponentStatus{DesiredReplicas: 3, ReadyReplicas: 2},
+	}
+
+	replicas, _ = getScaleStatus(quayEcosystem)
+	assert.Equal(t, int32(2), replicas)
+}
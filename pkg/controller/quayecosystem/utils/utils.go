@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -94,3 +96,26 @@ func MakeServiceAccountsUsername(namespace string, names []string) []string {
 func GetHostFromHostname(hostname string) string {
 	return strings.Split(hostname, ":")[0]
 }
+
+// Checksum returns a SHA-256 checksum of the provided content that does not depend on the order of the keys
+func Checksum(content map[string][]byte) string {
+
+	keys := make([]string, 0, len(content))
+
+	for key := range content {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	hash := sha256.New()
+
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(content[key])
+		hash.Write([]byte{0})
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
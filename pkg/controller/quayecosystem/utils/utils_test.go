@@ -135,3 +135,23 @@ func TestHostFromostname(t *testing.T) {
 	}
 
 }
+
+func TestChecksum(t *testing.T) {
+
+	content := map[string][]byte{
+		"config.yaml": []byte("SERVER_HOSTNAME: quay.example.com"),
+		"ssl.cert":    []byte("certificate"),
+	}
+
+	checksum := Checksum(content)
+
+	if checksum != Checksum(map[string][]byte{"ssl.cert": []byte("certificate"), "config.yaml": []byte("SERVER_HOSTNAME: quay.example.com")}) {
+		t.Errorf("Checksum changed with the order of the content")
+	}
+
+	content["config.yaml"] = []byte("SERVER_HOSTNAME: registry.example.com")
+
+	if checksum == Checksum(content) {
+		t.Errorf("Checksum did not change with the content")
+	}
+}
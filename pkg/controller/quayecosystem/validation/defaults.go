@@ -36,6 +36,7 @@ func SetDefaults(client client.Client, quayConfiguration *resources.QuayConfigur
 	quayConfiguration.ClairDatabase.Database = constants.ClairDatabaseCredentialsDefaultDatabaseName
 	quayConfiguration.ClairDatabase.RootPassword = constants.ClairDatabaseCredentialsDefaultRootPassword
 	quayConfiguration.ClairUpdateInterval = constants.ClairDefaultUpdateInterval
+	quayConfiguration.RegistrySmokeTestInterval = constants.RegistrySmokeTestDefaultInterval
 	quayConfiguration.DeployQuayConfiguration = true
 
 	if quayConfiguration.QuayEcosystem.Spec.Quay == nil {
@@ -302,6 +303,31 @@ func SetDefaults(client client.Client, quayConfiguration *resources.QuayConfigur
 		changed = true
 	}
 
+	// API Token
+	if quayConfiguration.QuayEcosystem.Spec.Quay.APIToken != nil {
+
+		if utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.Organization) {
+			quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.Organization = constants.QuayAPITokenDefaultOrganization
+			changed = true
+		}
+
+		if len(quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.Scopes) == 0 {
+			quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.Scopes = append([]redhatcopv1alpha1.QuayAPITokenScope{}, redhatcopv1alpha1.AllQuayAPITokenScopes...)
+			changed = true
+		}
+
+		if utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.SecretName) {
+			quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.SecretName = resources.GetQuayAPITokenSecretName(quayConfiguration.QuayEcosystem)
+			changed = true
+		}
+	}
+
+	// Registry Smoke Test
+	if quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest != nil && utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest.Organization) {
+		quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest.Organization = constants.RegistrySmokeTestDefaultOrganization
+		changed = true
+	}
+
 	return changed
 }
 
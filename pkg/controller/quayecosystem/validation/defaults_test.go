@@ -50,3 +50,45 @@ func TestDefaultConfiguration(t *testing.T) {
 	assert.Equal(t, registry, quayConfiguration.QuayEcosystem.Spec.Quay.RegistryBackends)
 
 }
+
+func TestDefaultAPITokenConfiguration(t *testing.T) {
+
+	cl := fake.NewFakeClient()
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+			Quay: &redhatcopv1alpha1.Quay{
+				APIToken: &redhatcopv1alpha1.APIToken{},
+			},
+		},
+	}
+	quayEcosystem.Name = "quay-ecosystem"
+	quayConfiguration := resources.QuayConfiguration{
+		QuayEcosystem: quayEcosystem,
+	}
+
+	SetDefaults(cl, &quayConfiguration)
+
+	assert.Equal(t, constants.QuayAPITokenDefaultOrganization, quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.Organization)
+	assert.Equal(t, redhatcopv1alpha1.AllQuayAPITokenScopes, quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.Scopes)
+	assert.Equal(t, "quay-ecosystem-quay-api-token", quayConfiguration.QuayEcosystem.Spec.Quay.APIToken.SecretName)
+}
+
+func TestDefaultRegistrySmokeTestConfiguration(t *testing.T) {
+
+	cl := fake.NewFakeClient()
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+			Quay: &redhatcopv1alpha1.Quay{
+				SmokeTest: &redhatcopv1alpha1.RegistrySmokeTest{},
+			},
+		},
+	}
+	quayConfiguration := resources.QuayConfiguration{
+		QuayEcosystem: quayEcosystem,
+	}
+
+	SetDefaults(cl, &quayConfiguration)
+
+	assert.Equal(t, constants.RegistrySmokeTestDefaultOrganization, quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest.Organization)
+	assert.Equal(t, constants.RegistrySmokeTestDefaultInterval, quayConfiguration.RegistrySmokeTestInterval)
+}
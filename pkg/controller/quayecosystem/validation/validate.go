@@ -23,6 +23,10 @@ import (
 // Validate performs validation across all resources
 func Validate(client client.Client, quayConfiguration *resources.QuayConfiguration) (bool, error) {
 
+	if err := ValidateSpec(quayConfiguration); err != nil {
+		return false, err
+	}
+
 	// Validate Initial Superuser Credentials Secret
 	if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.SuperuserCredentialsSecretName) && !quayConfiguration.QuayEcosystem.Spec.Quay.SkipSetup && !quayConfiguration.QuayEcosystem.Status.SetupComplete {
 
@@ -126,10 +130,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 	// Validate Quay Database Credential
 	if !quayConfiguration.QuayEcosystem.Spec.Quay.SkipSetup {
-		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database) && !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database.Server) && utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database.CredentialsSecretName) {
-			return false, fmt.Errorf("Failed to locate a Quay Database Credential for Externally Provisioned Instance")
-		}
-
 		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database) && !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database.CredentialsSecretName) {
 
 			validQuayDatabaseSecret, databaseSecret, err := validateSecret(client, quayConfiguration.QuayEcosystem.Namespace, quayConfiguration.QuayEcosystem.Spec.Quay.Database.CredentialsSecretName, constants.RequiredDatabaseCredentialKeys)
@@ -163,27 +163,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 		}
 	}
 
-	// Validate Quay Database
-	if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database.VolumeSize) {
-
-		_, err := resource.ParseQuantity(quayConfiguration.QuayEcosystem.Spec.Quay.Database.VolumeSize)
-
-		if err != nil {
-			return false, err
-		}
-	}
-
-	// Quay PVC Generation
-	if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.RegistryStorage) {
-
-		_, err := resource.ParseQuantity(quayConfiguration.QuayEcosystem.Spec.Quay.RegistryStorage.PersistentVolumeSize)
-
-		if err != nil {
-			return false, err
-		}
-
-	}
-
 	// Validate Quay Config Files
 	if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.ConfigFiles) {
 
@@ -197,35 +176,14 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 	}
 
-	// Validate Hostname Provided if NodePort external access
-	if (redhatcopv1alpha1.NodePortExternalAccessType == quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type || redhatcopv1alpha1.IngressExternalAccessType == quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type) && utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Hostname) {
-		return false, fmt.Errorf("Cannot use %s External Access Type Without Hostname Defined", quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type)
-	}
-
-	// Validate Route not specified when not running in OpenShift
-	if redhatcopv1alpha1.RouteExternalAccessType == quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type && !quayConfiguration.IsOpenShift {
-		return false, fmt.Errorf("Cannot use 'Route` as External Access Type when not running in OpenShift")
-	}
-
 	// Registry Backends
 	for _, registryBackend := range quayConfiguration.QuayEcosystem.Spec.Quay.RegistryBackends {
 
-		// Validate replication is not enabled when using a Local backend
-		if quayConfiguration.QuayEcosystem.Spec.Quay.EnableStorageReplication {
-			if registryBackend.Local != nil {
-				return false, fmt.Errorf("Cannot have make use of local storage when replication enabled. Local storage: %s", registryBackend.Name)
-			}
-		}
-
 		managedRegistryBackend := registryBackend.DeepCopy()
 
 		// Validate S3 backend
 		if !utils.IsZeroOfUnderlyingType(managedRegistryBackend.S3) {
 
-			if managedRegistryBackend.S3.StoragePath == "" || managedRegistryBackend.S3.BucketName == "" {
-				return false, fmt.Errorf("Failed to validate required properties for registry backend. Name: %s", managedRegistryBackend.Name)
-			}
-
 			if !utils.IsZeroOfUnderlyingType(managedRegistryBackend.CredentialsSecretName) {
 				validS3Secret, s3Secret, err := validateSecret(client, quayConfiguration.QuayEcosystem.Namespace, registryBackend.CredentialsSecretName, constants.RequiredS3CredentialKeys)
 
@@ -247,10 +205,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 		// Validate Azure backend
 		if !utils.IsZeroOfUnderlyingType(managedRegistryBackend.Azure) {
 
-			if managedRegistryBackend.Azure.StoragePath == "" || managedRegistryBackend.Azure.ContainerName == "" {
-				return false, fmt.Errorf("Failed to validate provided registry backend. Name: %s", managedRegistryBackend.Name)
-			}
-
 			if !utils.IsZeroOfUnderlyingType(managedRegistryBackend.CredentialsSecretName) {
 				validAzureSecret, azureSecret, err := validateSecret(client, quayConfiguration.QuayEcosystem.Namespace, registryBackend.CredentialsSecretName, constants.RequiredAzureCredentialKeys)
 
@@ -295,10 +249,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 			}
 
-			if managedRegistryBackend.GoogleCloud.StoragePath == "" || managedRegistryBackend.GoogleCloud.BucketName == "" {
-				return false, fmt.Errorf("Failed to validate provided registry backend. Name: %s", managedRegistryBackend.Name)
-			}
-
 		}
 
 		// Validate RHOCS backend
@@ -322,10 +272,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 			}
 
-			if managedRegistryBackend.RHOCS.StoragePath == "" || managedRegistryBackend.RHOCS.BucketName == "" {
-				return false, fmt.Errorf("Failed to validate provided registry backend. Name: %s", managedRegistryBackend.Name)
-			}
-
 		}
 
 		// Validate RADOS backend
@@ -349,10 +295,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 			}
 
-			if managedRegistryBackend.RADOS.StoragePath == "" || managedRegistryBackend.RADOS.BucketName == "" {
-				return false, fmt.Errorf("Failed to validate provided registry backend. Name: %s", managedRegistryBackend.Name)
-			}
-
 		}
 
 		// Validate Swift backend
@@ -376,10 +318,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 			}
 
-			if managedRegistryBackend.Swift.StoragePath == "" || managedRegistryBackend.Swift.Container == "" {
-				return false, fmt.Errorf("Failed to validate provided registry backend. Name: %s", managedRegistryBackend.Name)
-			}
-
 		}
 
 		// Validate Cloudfront S3 backend
@@ -403,10 +341,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 			}
 
-			if managedRegistryBackend.CloudfrontS3.StoragePath == "" || managedRegistryBackend.CloudfrontS3.BucketName == "" {
-				return false, fmt.Errorf("Failed to validate provided registry backend. Name: %s", managedRegistryBackend.Name)
-			}
-
 		}
 
 		quayConfiguration.RegistryBackends = append(quayConfiguration.RegistryBackends, *managedRegistryBackend)
@@ -447,22 +381,6 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 
 		}
 
-		// Validate Update Interval
-		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.UpdateInterval) {
-
-			duration, durationErr := time.ParseDuration(quayConfiguration.QuayEcosystem.Spec.Clair.UpdateInterval)
-
-			if durationErr != nil {
-				return false, durationErr
-			}
-
-			quayConfiguration.ClairUpdateInterval = duration
-		}
-
-		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database) && !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database.Server) && utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database.CredentialsSecretName) {
-			return false, fmt.Errorf("Failed to locate a Clair Database Credential for Externally Provisioned Instance")
-		}
-
 		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database) && !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database.CredentialsSecretName) {
 
 			validClairDatabaseSecret, databaseSecret, err := validateSecret(client, quayConfiguration.QuayEcosystem.Namespace, quayConfiguration.QuayEcosystem.Spec.Clair.Database.CredentialsSecretName, constants.RequiredDatabaseCredentialKeys)
@@ -514,6 +432,139 @@ func Validate(client client.Client, quayConfiguration *resources.QuayConfigurati
 	return true, nil
 }
 
+// ValidateSpec performs the validation of the QuayEcosystem which does not depend on other resources
+func ValidateSpec(quayConfiguration *resources.QuayConfiguration) error {
+
+	// Validate Quay Database Credential
+	if !quayConfiguration.QuayEcosystem.Spec.Quay.SkipSetup {
+		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database) && !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database.Server) && utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database.CredentialsSecretName) {
+			return fmt.Errorf("Failed to locate a Quay Database Credential for Externally Provisioned Instance")
+		}
+	}
+
+	// Validate Quay Database
+	if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.Database.VolumeSize) {
+
+		_, err := resource.ParseQuantity(quayConfiguration.QuayEcosystem.Spec.Quay.Database.VolumeSize)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// Quay PVC Generation
+	if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.RegistryStorage) {
+
+		_, err := resource.ParseQuantity(quayConfiguration.QuayEcosystem.Spec.Quay.RegistryStorage.PersistentVolumeSize)
+
+		if err != nil {
+			return err
+		}
+
+	}
+
+	// Validate Hostname Provided if NodePort external access
+	if (redhatcopv1alpha1.NodePortExternalAccessType == quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type || redhatcopv1alpha1.IngressExternalAccessType == quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type) && utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Hostname) {
+		return fmt.Errorf("Cannot use %s External Access Type Without Hostname Defined", quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type)
+	}
+
+	// Validate Route not specified when not running in OpenShift
+	if redhatcopv1alpha1.RouteExternalAccessType == quayConfiguration.QuayEcosystem.Spec.Quay.ExternalAccess.Type && !quayConfiguration.IsOpenShift {
+		return fmt.Errorf("Cannot use 'Route` as External Access Type when not running in OpenShift")
+	}
+
+	// Validate HorizontalPodAutoscalers
+	if err := validateHorizontalPodAutoscaler(quayConfiguration.QuayEcosystem.Spec.Quay.HorizontalPodAutoscaler, "Quay"); err != nil {
+		return err
+	}
+
+	if err := validateHorizontalPodAutoscaler(quayConfiguration.QuayEcosystem.Spec.Quay.RepoMirrorHorizontalPodAutoscaler, "Quay Repo Mirror"); err != nil {
+		return err
+	}
+
+	// Validate Registry Smoke Test Interval
+	if quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest != nil && !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest.Interval) {
+
+		duration, durationErr := time.ParseDuration(quayConfiguration.QuayEcosystem.Spec.Quay.SmokeTest.Interval)
+
+		if durationErr != nil {
+			return durationErr
+		}
+
+		if duration <= 0 {
+			return fmt.Errorf("Registry smoke test interval must be positive")
+		}
+
+		quayConfiguration.RegistrySmokeTestInterval = duration
+	}
+
+	// Registry Backends
+	for _, registryBackend := range quayConfiguration.QuayEcosystem.Spec.Quay.RegistryBackends {
+
+		// Validate replication is not enabled when using a Local backend
+		if quayConfiguration.QuayEcosystem.Spec.Quay.EnableStorageReplication {
+			if registryBackend.Local != nil {
+				return fmt.Errorf("Cannot have make use of local storage when replication enabled. Local storage: %s", registryBackend.Name)
+			}
+		}
+
+		if registryBackend.S3 != nil && (registryBackend.S3.StoragePath == "" || registryBackend.S3.BucketName == "") {
+			return fmt.Errorf("Failed to validate required properties for registry backend. Name: %s", registryBackend.Name)
+		}
+
+		if (registryBackend.Azure != nil && (registryBackend.Azure.StoragePath == "" || registryBackend.Azure.ContainerName == "")) ||
+			(registryBackend.GoogleCloud != nil && (registryBackend.GoogleCloud.StoragePath == "" || registryBackend.GoogleCloud.BucketName == "")) ||
+			(registryBackend.RHOCS != nil && (registryBackend.RHOCS.StoragePath == "" || registryBackend.RHOCS.BucketName == "")) ||
+			(registryBackend.RADOS != nil && (registryBackend.RADOS.StoragePath == "" || registryBackend.RADOS.BucketName == "")) ||
+			(registryBackend.Swift != nil && (registryBackend.Swift.StoragePath == "" || registryBackend.Swift.Container == "")) ||
+			(registryBackend.CloudfrontS3 != nil && (registryBackend.CloudfrontS3.StoragePath == "" || registryBackend.CloudfrontS3.BucketName == "")) {
+			return fmt.Errorf("Failed to validate provided registry backend. Name: %s", registryBackend.Name)
+		}
+	}
+
+	if quayConfiguration.QuayEcosystem.Spec.Clair != nil && quayConfiguration.QuayEcosystem.Spec.Clair.Enabled {
+
+		// Validate Update Interval
+		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.UpdateInterval) {
+
+			duration, durationErr := time.ParseDuration(quayConfiguration.QuayEcosystem.Spec.Clair.UpdateInterval)
+
+			if durationErr != nil {
+				return durationErr
+			}
+
+			quayConfiguration.ClairUpdateInterval = duration
+		}
+
+		if err := validateHorizontalPodAutoscaler(quayConfiguration.QuayEcosystem.Spec.Clair.HorizontalPodAutoscaler, "Clair"); err != nil {
+			return err
+		}
+
+		if !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database) && !utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database.Server) && utils.IsZeroOfUnderlyingType(quayConfiguration.QuayEcosystem.Spec.Clair.Database.CredentialsSecretName) {
+			return fmt.Errorf("Failed to locate a Clair Database Credential for Externally Provisioned Instance")
+		}
+	}
+
+	return nil
+}
+
+func validateHorizontalPodAutoscaler(horizontalPodAutoscaler *redhatcopv1alpha1.HorizontalPodAutoscaler, component string) error {
+
+	if horizontalPodAutoscaler == nil {
+		return nil
+	}
+
+	if horizontalPodAutoscaler.MaxReplicas < 1 {
+		return fmt.Errorf("%s HorizontalPodAutoscaler maxReplicas must be at least 1", component)
+	}
+
+	if horizontalPodAutoscaler.MinReplicas != nil && *horizontalPodAutoscaler.MinReplicas > horizontalPodAutoscaler.MaxReplicas {
+		return fmt.Errorf("%s HorizontalPodAutoscaler minReplicas cannot be greater than maxReplicas", component)
+	}
+
+	return nil
+}
+
 func validateSecret(client client.Client, namespace string, name string, requiredParameters interface{}) (bool, *corev1.Secret, error) {
 
 	secret := &corev1.Secret{}
@@ -632,3 +683,52 @@ func validateConfigFiles(client client.Client, namespace string, inputConfigFile
 
 	return outputConfigFiles, nil
 }
+
+// ValidateUpdate verifies that fields which cannot be changed once Quay has been set up are left untouched
+func ValidateUpdate(oldQuayEcosystem *redhatcopv1alpha1.QuayEcosystem, newQuayEcosystem *redhatcopv1alpha1.QuayEcosystem) error {
+
+	if !oldQuayEcosystem.Status.SetupComplete {
+		return nil
+	}
+
+	if getQuayDatabaseServer(oldQuayEcosystem) != getQuayDatabaseServer(newQuayEcosystem) {
+		return fmt.Errorf("Quay Database Server cannot be modified after setup has completed")
+	}
+
+	if !reflect.DeepEqual(getRegistryBackends(oldQuayEcosystem), getRegistryBackends(newQuayEcosystem)) {
+		return fmt.Errorf("Quay Registry Backends cannot be modified after setup has completed")
+	}
+
+	if getClairDatabaseServer(oldQuayEcosystem) != getClairDatabaseServer(newQuayEcosystem) {
+		return fmt.Errorf("Clair Database Server cannot be modified after setup has completed")
+	}
+
+	return nil
+}
+
+func getQuayDatabaseServer(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) string {
+
+	if quayEcosystem.Spec.Quay == nil || quayEcosystem.Spec.Quay.Database == nil {
+		return ""
+	}
+
+	return quayEcosystem.Spec.Quay.Database.Server
+}
+
+func getClairDatabaseServer(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) string {
+
+	if quayEcosystem.Spec.Clair == nil || quayEcosystem.Spec.Clair.Database == nil {
+		return ""
+	}
+
+	return quayEcosystem.Spec.Clair.Database.Server
+}
+
+func getRegistryBackends(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) []redhatcopv1alpha1.RegistryBackend {
+
+	if quayEcosystem.Spec.Quay == nil || len(quayEcosystem.Spec.Quay.RegistryBackends) == 0 {
+		return nil
+	}
+
+	return quayEcosystem.Spec.Quay.RegistryBackends
+}
@@ -225,3 +225,152 @@ func TestValidateQuayDatabaseSecret(t *testing.T) {
 	assert.Equal(t, validQuaySuperuserSecret, true)
 	assert.Equal(t, superuserSecret, secret)
 }
+
+func TestValidateHorizontalPodAutoscaler(t *testing.T) {
+
+	minReplicas := int32(3)
+
+	cases := []struct {
+		name                    string
+		horizontalPodAutoscaler *redhatcopv1alpha1.HorizontalPodAutoscaler
+		valid                   bool
+	}{
+		{
+			name:  "none",
+			valid: true,
+		},
+		{
+			name:                    "valid",
+			horizontalPodAutoscaler: &redhatcopv1alpha1.HorizontalPodAutoscaler{MinReplicas: &minReplicas, MaxReplicas: 5},
+			valid:                   true,
+		},
+		{
+			name:                    "missing maxReplicas",
+			horizontalPodAutoscaler: &redhatcopv1alpha1.HorizontalPodAutoscaler{},
+			valid:                   false,
+		},
+		{
+			name:                    "minReplicas greater than maxReplicas",
+			horizontalPodAutoscaler: &redhatcopv1alpha1.HorizontalPodAutoscaler{MinReplicas: &minReplicas, MaxReplicas: 2},
+			valid:                   false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			cl := fake.NewFakeClient()
+			quayConfiguration := resources.QuayConfiguration{
+				QuayEcosystem: &redhatcopv1alpha1.QuayEcosystem{},
+				IsOpenShift:   true,
+			}
+
+			SetDefaults(cl, &quayConfiguration)
+			quayConfiguration.QuayEcosystem.Spec.Quay.HorizontalPodAutoscaler = c.horizontalPodAutoscaler
+
+			validate, err := Validate(cl, &quayConfiguration)
+
+			assert.Equal(t, c.valid, validate)
+			assert.Equal(t, c.valid, err == nil)
+		})
+	}
+}
+
+func TestValidateUpdateBeforeSetup(t *testing.T) {
+
+	oldQuayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+			Quay: &redhatcopv1alpha1.Quay{
+				Database: &redhatcopv1alpha1.Database{
+					Server: "postgresql-old",
+				},
+			},
+		},
+	}
+
+	newQuayEcosystem := oldQuayEcosystem.DeepCopy()
+	newQuayEcosystem.Spec.Quay.Database.Server = "postgresql-new"
+
+	err := ValidateUpdate(oldQuayEcosystem, newQuayEcosystem)
+
+	assert.NoError(t, err)
+}
+
+func TestValidateUpdateImmutableFields(t *testing.T) {
+
+	oldQuayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		Spec: redhatcopv1alpha1.QuayEcosystemSpec{
+			Quay: &redhatcopv1alpha1.Quay{
+				Database: &redhatcopv1alpha1.Database{
+					Server: "postgresql-old",
+				},
+				RegistryBackends: []redhatcopv1alpha1.RegistryBackend{
+					{
+						Name: "default",
+						RegistryBackendSource: redhatcopv1alpha1.RegistryBackendSource{
+							Local: &redhatcopv1alpha1.LocalRegistryBackendSource{
+								StoragePath: "/datastorage/registry",
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: redhatcopv1alpha1.QuayEcosystemStatus{
+			SetupComplete: true,
+		},
+	}
+
+	cases := []struct {
+		name   string
+		mutate func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem)
+		valid  bool
+	}{
+		{
+			name:   "unchanged",
+			mutate: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {},
+			valid:  true,
+		},
+		{
+			name: "mutable field",
+			mutate: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Spec.Quay.Replicas = new(int32)
+			},
+			valid: true,
+		},
+		{
+			name: "database server",
+			mutate: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Spec.Quay.Database.Server = "postgresql-new"
+			},
+			valid: false,
+		},
+		{
+			name: "registry backend",
+			mutate: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Spec.Quay.RegistryBackends[0].Local.StoragePath = "/datastorage/other"
+			},
+			valid: false,
+		},
+		{
+			name: "removed registry backends",
+			mutate: func(quayEcosystem *redhatcopv1alpha1.QuayEcosystem) {
+				quayEcosystem.Spec.Quay.RegistryBackends = nil
+			},
+			valid: false,
+		},
+	}
+
+	for _, c := range cases {
+		newQuayEcosystem := oldQuayEcosystem.DeepCopy()
+		c.mutate(newQuayEcosystem)
+
+		err := ValidateUpdate(oldQuayEcosystem, newQuayEcosystem)
+
+		if c.valid {
+			assert.NoError(t, err, c.name)
+		} else {
+			assert.Error(t, err, c.name)
+		}
+	}
+}
@@ -0,0 +1,402 @@
+package quaynotification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Add creates a new QuayNotification Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayNotification {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quaynotification-controller"))
+
+	return &ReconcileQuayNotification{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quaynotification-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayNotification
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayNotification{}}, &handler.EnqueueRequestForObject{}, testNotificationRequestedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	newList := func() runtime.Object {
+		return &redhatcopv1alpha1.QuayNotificationList{}
+	}
+
+	// Watch for changes to the Secrets containing the URLs receiving the notifications
+	err = quayapi.WatchReferencedSecrets(mgr, c, &redhatcopv1alpha1.QuayNotification{}, newList, func(obj runtime.Object) []string {
+
+		quayNotification, ok := obj.(*redhatcopv1alpha1.QuayNotification)
+
+		if !ok || quayNotification.GetURLSecretName() == "" {
+			return nil
+		}
+
+		return []string{quayNotification.GetURLSecretName()}
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayNotification{}, newList)
+}
+
+// testNotificationRequestedPredicate additionally fires an update event when a test notification is requested
+type testNotificationRequestedPredicate struct {
+	util.ResourceGenerationOrFinalizerChangedPredicate
+}
+
+// Update determines whether the specification, finalizers or test notification annotation changed
+func (p testNotificationRequestedPredicate) Update(e event.UpdateEvent) bool {
+
+	if p.ResourceGenerationOrFinalizerChangedPredicate.Update(e) {
+		return true
+	}
+
+	if e.MetaOld == nil || e.MetaNew == nil {
+		return false
+	}
+
+	return e.MetaOld.GetAnnotations()[constants.TestNotificationAnnotationKey] != e.MetaNew.GetAnnotations()[constants.TestNotificationAnnotationKey]
+}
+
+// blank assignment to verify that ReconcileQuayNotification implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayNotification{}
+
+// ReconcileQuayNotification reconciles a QuayNotification object
+type ReconcileQuayNotification struct {
+	quayapi.ResourceReconciler
+}
+
+// Reconcile synchronizes the notification of the repository in Quay with the QuayNotification and triggers a test notification when requested
+func (r *ReconcileQuayNotification) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayNotification")
+
+	quayNotification := &redhatcopv1alpha1.QuayNotification{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayNotification)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsBeingDeleted(quayNotification) {
+		return r.ManageDeletion(quayNotification, func(quayInstance *quayapi.QuayInstance) error {
+			return deleteNotification(quayInstance.QuayClient, quayNotification.Status.RepositoryName, quayNotification.Status.UUID)
+		})
+	}
+
+	updated, err := r.ManageFinalizer(quayNotification)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	desired, err := r.getNotificationRequest(quayNotification)
+	if err != nil {
+		return r.ManageError(quayNotification, redhatcopv1alpha1.QuayResourceValidationFailure, err)
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayNotification)
+	if err != nil {
+		return r.ManageError(quayNotification, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = syncNotification(quayInstance.QuayClient, quayNotification, desired)
+	if err != nil {
+		return r.ManageError(quayNotification, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = manageTestNotification(quayInstance.QuayClient, quayNotification)
+	if err != nil {
+		return r.ManageError(quayNotification, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	return r.ManageSuccess(quayNotification, "Notification Synchronized Successfully")
+}
+
+// getNotificationRequest returns the notification declared by the QuayNotification including the URL contained in the referenced Secret
+func (r *ReconcileQuayNotification) getNotificationRequest(quayNotification *redhatcopv1alpha1.QuayNotification) (qclient.RepositoryNotificationCreateRequest, error) {
+
+	spec := quayNotification.Spec
+	config := map[string]string{}
+
+	switch spec.Method {
+	case redhatcopv1alpha1.WebhookQuayNotificationMethod:
+		if spec.Webhook == nil {
+			return qclient.RepositoryNotificationCreateRequest{}, fmt.Errorf("Webhook configuration is required for the webhook method")
+		}
+
+		url, err := r.getURL(quayNotification, spec.Webhook.URL, spec.Webhook.URLSecretName)
+		if err != nil {
+			return qclient.RepositoryNotificationCreateRequest{}, err
+		}
+
+		config["url"] = url
+
+		if spec.Webhook.BodyTemplate != "" {
+			if !json.Valid([]byte(spec.Webhook.BodyTemplate)) {
+				return qclient.RepositoryNotificationCreateRequest{}, fmt.Errorf("Webhook body template is not valid JSON")
+			}
+			config["template"] = spec.Webhook.BodyTemplate
+		}
+
+	case redhatcopv1alpha1.EmailQuayNotificationMethod:
+		if spec.Email == nil || spec.Email.Address == "" {
+			return qclient.RepositoryNotificationCreateRequest{}, fmt.Errorf("Email address is required for the email method")
+		}
+
+		config["email"] = spec.Email.Address
+
+	case redhatcopv1alpha1.SlackQuayNotificationMethod:
+		if spec.Slack == nil {
+			return qclient.RepositoryNotificationCreateRequest{}, fmt.Errorf("Slack configuration is required for the slack method")
+		}
+
+		url, err := r.getURL(quayNotification, spec.Slack.URL, spec.Slack.URLSecretName)
+		if err != nil {
+			return qclient.RepositoryNotificationCreateRequest{}, err
+		}
+
+		config["url"] = url
+
+	default:
+		return qclient.RepositoryNotificationCreateRequest{}, fmt.Errorf("Unsupported notification method %s", spec.Method)
+	}
+
+	eventConfig := spec.EventConfig
+	if eventConfig == nil {
+		eventConfig = map[string]string{}
+	}
+
+	return qclient.RepositoryNotificationCreateRequest{
+		Event:       string(spec.Event),
+		Method:      string(spec.Method),
+		Config:      config,
+		EventConfig: eventConfig,
+		Title:       quayNotification.GetTitle(),
+	}, nil
+}
+
+// getURL returns the URL receiving the notification either specified directly or contained in the referenced Secret
+func (r *ReconcileQuayNotification) getURL(quayNotification *redhatcopv1alpha1.QuayNotification, url string, secretName string) (string, error) {
+
+	if (url == "") == (secretName == "") {
+		return "", fmt.Errorf("Exactly one of url or urlSecretName must be specified for the %s method", quayNotification.Spec.Method)
+	}
+
+	if secretName == "" {
+		return url, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: quayNotification.Namespace, Name: secretName}, secret)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", fmt.Errorf("URL secret %s not found", secretName)
+		}
+		return "", err
+	}
+
+	if len(secret.Data[constants.NotificationURLSecretKey]) == 0 {
+		return "", fmt.Errorf("URL secret %s is missing key %s", secretName, constants.NotificationURLSecretKey)
+	}
+
+	return string(secret.Data[constants.NotificationURLSecretKey]), nil
+}
+
+// syncNotification creates the notification of the repository or recreates it when its configuration changed since Quay does not support updating notifications
+func syncNotification(quayClient *qclient.QuayClient, quayNotification *redhatcopv1alpha1.QuayNotification, desired qclient.RepositoryNotificationCreateRequest) error {
+
+	fullName := quayNotification.GetFullRepositoryName()
+
+	// The notification is removed from the previous repository when the repository changes
+	if quayNotification.Status.RepositoryName != "" && quayNotification.Status.RepositoryName != fullName {
+
+		if err := deleteNotification(quayClient, quayNotification.Status.RepositoryName, quayNotification.Status.UUID); err != nil {
+			return err
+		}
+
+		quayNotification.Status.UUID = ""
+		quayNotification.Status.ConfigChecksum = ""
+	}
+
+	quayNotification.Status.RepositoryName = fullName
+
+	content, err := json.Marshal(desired)
+	if err != nil {
+		return err
+	}
+
+	checksum := utils.Checksum(map[string][]byte{"notification": content})
+
+	existing, err := findNotification(quayClient, quayNotification)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && checksum == quayNotification.Status.ConfigChecksum {
+		quayNotification.Status.NumberOfFailures = int32(existing.NumberOfFailures)
+		return nil
+	}
+
+	namespace, repository := quayNotification.Spec.Namespace, quayNotification.Spec.Repository
+
+	if existing != nil {
+		logging.Log.Info("Recreating Notification", "Namespace", quayNotification.Namespace, "Name", quayNotification.Name, "Repository", fullName)
+
+		if err := deleteNotification(quayClient, fullName, existing.UUID); err != nil {
+			return err
+		}
+	} else {
+		logging.Log.Info("Creating Notification", "Namespace", quayNotification.Namespace, "Name", quayNotification.Name, "Repository", fullName)
+	}
+
+	_, notification, err := quayClient.CreateRepositoryNotification(context.TODO(), namespace, repository, desired)
+
+	if qclient.IsNotFound(err) {
+		return fmt.Errorf("Repository %s does not exist", fullName)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	quayNotification.Status.UUID = notification.UUID
+	quayNotification.Status.ConfigChecksum = checksum
+	quayNotification.Status.NumberOfFailures = 0
+
+	return nil
+}
+
+// findNotification returns the notification managed by the QuayNotification identified by its UUID or, when it is not known, by its title
+func findNotification(quayClient *qclient.QuayClient, quayNotification *redhatcopv1alpha1.QuayNotification) (*qclient.RepositoryNotification, error) {
+
+	namespace, repository := quayNotification.Spec.Namespace, quayNotification.Spec.Repository
+
+	if quayNotification.Status.UUID != "" {
+		_, notification, err := quayClient.GetRepositoryNotification(context.TODO(), namespace, repository, quayNotification.Status.UUID)
+
+		if !qclient.IsNotFound(err) {
+			if err != nil {
+				return nil, err
+			}
+
+			return &notification, nil
+		}
+	}
+
+	_, notifications, err := quayClient.GetRepositoryNotifications(context.TODO(), namespace, repository)
+
+	if qclient.IsNotFound(err) {
+		return nil, fmt.Errorf("Repository %s does not exist", quayNotification.GetFullRepositoryName())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, notification := range notifications.Notifications {
+		if notification.Title == quayNotification.GetTitle() {
+			return &notification, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// manageTestNotification triggers a test notification when requested through the test annotation
+func manageTestNotification(quayClient *qclient.QuayClient, quayNotification *redhatcopv1alpha1.QuayNotification) error {
+
+	testRequest := quayNotification.Annotations[constants.TestNotificationAnnotationKey]
+
+	if testRequest == "" || testRequest == quayNotification.Status.TestRequest {
+		return nil
+	}
+
+	logging.Log.Info("Triggering Test Notification", "Namespace", quayNotification.Namespace, "Name", quayNotification.Name, "Repository", quayNotification.GetFullRepositoryName())
+
+	_, err := quayClient.TestRepositoryNotification(context.TODO(), quayNotification.Spec.Namespace, quayNotification.Spec.Repository, quayNotification.Status.UUID)
+
+	if err != nil {
+		return fmt.Errorf("Failed to trigger test notification: %s", err.Error())
+	}
+
+	now := metav1.Now()
+
+	quayNotification.Status.TestRequest = testRequest
+	quayNotification.Status.LastTestTime = &now
+
+	return nil
+}
+
+// deleteNotification deletes the notification of the repository unless it no longer exists
+func deleteNotification(quayClient *qclient.QuayClient, fullName string, uuid string) error {
+
+	if fullName == "" || uuid == "" {
+		return nil
+	}
+
+	namespace, name := splitRepositoryName(fullName)
+
+	_, err := quayClient.DeleteRepositoryNotification(context.TODO(), namespace, name, uuid)
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// splitRepositoryName returns the namespace and name of a full repository name
+func splitRepositoryName(fullName string) (string, string) {
+
+	parts := strings.SplitN(fullName, "/", 2)
+
+	if len(parts) != 2 {
+		return "", fullName
+	}
+
+	return parts[0], parts[1]
+}
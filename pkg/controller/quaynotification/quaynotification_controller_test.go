@@ -0,0 +1,235 @@
+package quaynotification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var name = "example-notification"
+var namespace = "quay-enterprise"
+var urlSecretName = "example-notification-slack"
+
+// fakeQuay is the state held by the fake Quay server
+type fakeQuay struct {
+	notifications map[string]qclient.RepositoryNotification
+	created       int
+	tests         int
+}
+
+// newQuayServer returns a server implementing the notification endpoints of the Quay API for the example/app repository
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		if !strings.HasPrefix(r.URL.Path, "/api/v1/repository/example/app/notification/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/repository/example/app/notification/"), "/")
+		notification, found := quay.notifications[parts[0]]
+
+		switch {
+		case parts[0] == "" && r.Method == http.MethodGet:
+			notifications := qclient.RepositoryNotifications{Notifications: []qclient.RepositoryNotification{}}
+			for _, notification := range quay.notifications {
+				notifications.Notifications = append(notifications.Notifications, notification)
+			}
+			json.NewEncoder(w).Encode(notifications)
+		case parts[0] == "" && r.Method == http.MethodPost:
+			request := qclient.RepositoryNotificationCreateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			quay.created++
+			config := map[string]interface{}{}
+			for key, value := range request.Config {
+				config[key] = value
+			}
+			notification := qclient.RepositoryNotification{UUID: fmt.Sprintf("uuid-%d", quay.created), Title: request.Title, Event: request.Event, Method: request.Method, Config: config}
+			quay.notifications[notification.UUID] = notification
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(notification)
+		case !found:
+			w.WriteHeader(http.StatusNotFound)
+		case len(parts) == 2 && parts[1] == "test":
+			quay.tests++
+			w.Write([]byte("{}"))
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(notification)
+		case r.Method == http.MethodDelete:
+			delete(quay.notifications, parts[0])
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func newQuayNotification() *redhatcopv1alpha1.QuayNotification {
+	return &redhatcopv1alpha1.QuayNotification{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayNotificationSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Namespace:  "example",
+			Repository: "app",
+			Event:      redhatcopv1alpha1.RepoPushQuayNotificationEvent,
+			Method:     redhatcopv1alpha1.SlackQuayNotificationMethod,
+			Slack: &redhatcopv1alpha1.QuayNotificationSlack{
+				URLSecretName: urlSecretName,
+			},
+		},
+	}
+}
+
+func newURLSecret(url string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      urlSecretName,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			constants.NotificationURLSecretKey: []byte(url),
+		},
+	}
+}
+
+func TestReconcileNotification(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{notifications: map[string]qclient.RepositoryNotification{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayNotification := newQuayNotification()
+
+	r := ReconcileQuayNotification{ResourceReconciler: testutil.NewResourceReconciler(quayNotification, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace), newURLSecret("https://hooks.slack.com/services/initial"))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Len(t, quay.notifications, 1)
+	assert.Equal(t, "uuid-1", quayNotification.Status.UUID)
+	assert.Equal(t, "example/app", quayNotification.Status.RepositoryName)
+	assert.Equal(t, name, quay.notifications["uuid-1"].Title)
+	assert.Equal(t, "slack", quay.notifications["uuid-1"].Method)
+	assert.Equal(t, "https://hooks.slack.com/services/initial", quay.notifications["uuid-1"].Config["url"])
+
+	// An unchanged notification is not recreated
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, quay.created)
+
+	// A changed URL recreates the notification
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), newURLSecret("https://hooks.slack.com/services/rotated")))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Len(t, quay.notifications, 1)
+	assert.Equal(t, "uuid-2", quayNotification.Status.UUID)
+	assert.Equal(t, "https://hooks.slack.com/services/rotated", quay.notifications["uuid-2"].Config["url"])
+
+	// A test notification is triggered once per request
+	quayNotification.Annotations = map[string]string{constants.TestNotificationAnnotationKey: "1"}
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), quayNotification))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, quay.tests)
+	assert.Equal(t, "1", quayNotification.Status.TestRequest)
+	assert.NotNil(t, quayNotification.Status.LastTestTime)
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, quay.tests)
+}
+
+func TestReconcileNotificationAdoptsExisting(t *testing.T) {
+	testutil.SetupLogging()
+
+	// A notification with the same title left by a previous synchronization is replaced rather than duplicated
+	quay := &fakeQuay{notifications: map[string]qclient.RepositoryNotification{
+		"existing": {UUID: "existing", Title: name, Event: "repo_push", Method: "slack"},
+	}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayNotification := newQuayNotification()
+
+	r := ReconcileQuayNotification{ResourceReconciler: testutil.NewResourceReconciler(quayNotification, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace), newURLSecret("https://hooks.slack.com/services/initial"))}
+
+	_, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Len(t, quay.notifications, 1)
+	assert.Equal(t, "uuid-1", quayNotification.Status.UUID)
+}
+
+func TestReconcileNotificationValidation(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{notifications: map[string]qclient.RepositoryNotification{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	// The referenced Secret does not exist
+	quayNotification := newQuayNotification()
+
+	r := ReconcileQuayNotification{ResourceReconciler: testutil.NewResourceReconciler(quayNotification, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Empty(t, quay.notifications)
+
+	syncedCondition, found := quayNotification.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceValidationFailure), syncedCondition.Reason)
+}
+
+func TestReconcileNotificationDeletion(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{notifications: map[string]qclient.RepositoryNotification{
+		"uuid-1": {UUID: "uuid-1", Title: name},
+	}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	deletionTimestamp := metav1.Now()
+
+	quayNotification := newQuayNotification()
+	quayNotification.Spec.DeletionPolicy = redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy
+	quayNotification.Finalizers = []string{quayapi.QuayResourceFinalizer}
+	quayNotification.DeletionTimestamp = &deletionTimestamp
+	quayNotification.Status.RepositoryName = "example/app"
+	quayNotification.Status.UUID = "uuid-1"
+
+	r := ReconcileQuayNotification{ResourceReconciler: testutil.NewResourceReconciler(quayNotification, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	_, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayNotification)
+
+	assert.NoError(t, err)
+	assert.Empty(t, quay.notifications)
+	assert.NotContains(t, quayNotification.Finalizers, quayapi.QuayResourceFinalizer)
+}
@@ -0,0 +1,166 @@
+package quayorganization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Add creates a new QuayOrganization Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayOrganization {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quayorganization-controller"))
+
+	return &ReconcileQuayOrganization{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quayorganization-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayOrganization
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayOrganization{}}, &handler.EnqueueRequestForObject{}, util.ResourceGenerationOrFinalizerChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayOrganization{}, func() runtime.Object {
+		return &redhatcopv1alpha1.QuayOrganizationList{}
+	})
+}
+
+// blank assignment to verify that ReconcileQuayOrganization implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayOrganization{}
+
+// ReconcileQuayOrganization reconciles a QuayOrganization object
+type ReconcileQuayOrganization struct {
+	quayapi.ResourceReconciler
+}
+
+// Reconcile synchronizes the organization in Quay with the QuayOrganization
+func (r *ReconcileQuayOrganization) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayOrganization")
+
+	quayOrganization := &redhatcopv1alpha1.QuayOrganization{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayOrganization)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsBeingDeleted(quayOrganization) {
+		return r.ManageDeletion(quayOrganization, func(quayInstance *quayapi.QuayInstance) error {
+			return deleteOrganization(quayInstance.QuayClient, getManagedOrganizationName(quayOrganization))
+		})
+	}
+
+	updated, err := r.ManageFinalizer(quayOrganization)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	// Organizations cannot be renamed
+	if quayOrganization.Status.OrganizationName != "" && quayOrganization.Status.OrganizationName != quayOrganization.GetOrganizationName() {
+		return r.ManageError(quayOrganization, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Organization %s cannot be renamed to %s", quayOrganization.Status.OrganizationName, quayOrganization.GetOrganizationName()))
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayOrganization)
+	if err != nil {
+		return r.ManageError(quayOrganization, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = syncOrganization(quayInstance.QuayClient, quayOrganization)
+	if err != nil {
+		return r.ManageError(quayOrganization, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	quayOrganization.Status.OrganizationName = quayOrganization.GetOrganizationName()
+
+	return r.ManageSuccess(quayOrganization, "Organization Synchronized Successfully")
+}
+
+// syncOrganization creates the organization or updates it when it differs from the specification
+func syncOrganization(quayClient *qclient.QuayClient, quayOrganization *redhatcopv1alpha1.QuayOrganization) error {
+
+	organizationName := quayOrganization.GetOrganizationName()
+
+	_, organization, err := quayClient.GetOrganization(context.TODO(), organizationName)
+
+	if qclient.IsNotFound(err) {
+		logging.Log.Info("Creating Organization", "Namespace", quayOrganization.Namespace, "Name", quayOrganization.Name, "Organization", organizationName)
+
+		_, _, err := quayClient.CreateOrganization(context.TODO(), qclient.OrganizationCreateRequest{
+			Name:  organizationName,
+			Email: quayOrganization.Spec.Email,
+		})
+
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if quayOrganization.Spec.Email != "" && quayOrganization.Spec.Email != organization.Email {
+		logging.Log.Info("Updating Organization", "Namespace", quayOrganization.Namespace, "Name", quayOrganization.Name, "Organization", organizationName)
+
+		_, _, err := quayClient.UpdateOrganization(context.TODO(), organizationName, qclient.OrganizationUpdateRequest{
+			Email: quayOrganization.Spec.Email,
+		})
+
+		return err
+	}
+
+	return nil
+}
+
+// deleteOrganization deletes the organization from Quay unless it no longer exists
+func deleteOrganization(quayClient *qclient.QuayClient, organizationName string) error {
+
+	_, err := quayClient.DeleteOrganization(context.TODO(), organizationName)
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// getManagedOrganizationName returns the name of the organization created in Quay
+func getManagedOrganizationName(quayOrganization *redhatcopv1alpha1.QuayOrganization) string {
+
+	if quayOrganization.Status.OrganizationName != "" {
+		return quayOrganization.Status.OrganizationName
+	}
+
+	return quayOrganization.GetOrganizationName()
+}
@@ -0,0 +1,266 @@
+package quayorganization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var name = "example-quayorganization"
+var namespace = "quay-enterprise"
+
+// newQuayServer returns a server implementing the organization endpoints of the Quay API backed by the provided organizations
+func newQuayServer(organizations map[string]qclient.Organization) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		orgName := strings.TrimPrefix(r.URL.Path, "/api/v1/organization/")
+		organization, found := organizations[orgName]
+
+		switch {
+		case r.Method == http.MethodPost && orgName == "":
+			request := qclient.OrganizationCreateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			organizations[request.Name] = qclient.Organization{Name: request.Name, Email: request.Email}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`"Created"`))
+		case !found:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_message": "Not Found"}`))
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(organization)
+		case r.Method == http.MethodPut:
+			request := qclient.OrganizationUpdateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			organization.Email = request.Email
+			organizations[orgName] = organization
+			json.NewEncoder(w).Encode(organization)
+		case r.Method == http.MethodDelete:
+			delete(organizations, orgName)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func TestReconcileCreatesAndUpdatesOrganization(t *testing.T) {
+	testutil.SetupLogging()
+
+	organizations := map[string]qclient.Organization{}
+	server := newQuayServer(organizations)
+	defer server.Close()
+
+	quayOrganization := &redhatcopv1alpha1.QuayOrganization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayOrganizationSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Email: "before@example.com",
+		},
+	}
+
+	r := ReconcileQuayOrganization{ResourceReconciler: testutil.NewResourceReconciler(quayOrganization, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Equal(t, "before@example.com", organizations[name].Email)
+	assert.Equal(t, name, quayOrganization.Status.OrganizationName)
+	assert.NotNil(t, quayOrganization.Status.LastSyncTime)
+
+	syncedCondition, found := quayOrganization.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionTrue, syncedCondition.Status)
+
+	// Drift in Quay is repaired
+	organizations[name] = qclient.Organization{Name: name, Email: "drifted@example.com"}
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "before@example.com", organizations[name].Email)
+
+	// Organizations cannot be renamed
+	quayOrganization.Spec.Name = "renamed"
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), quayOrganization))
+
+	result, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.NotContains(t, organizations, "renamed")
+
+	syncedCondition, _ = quayOrganization.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceValidationFailure), syncedCondition.Reason)
+}
+
+func TestReconcileQuayEcosystemNotReady(t *testing.T) {
+	testutil.SetupLogging()
+
+	quayOrganization := &redhatcopv1alpha1.QuayOrganization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayOrganizationSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+		},
+	}
+
+	r := ReconcileQuayOrganization{ResourceReconciler: testutil.NewResourceReconciler(quayOrganization)}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.NotReadyRequeuePeriod, result.RequeueAfter)
+
+	syncedCondition, found := quayOrganization.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceQuayEcosystemNotReady), syncedCondition.Reason)
+}
+
+func TestReconcileDeletionPolicy(t *testing.T) {
+	testutil.SetupLogging()
+
+	organizations := map[string]qclient.Organization{}
+	server := newQuayServer(organizations)
+	defer server.Close()
+
+	quayOrganization := &redhatcopv1alpha1.QuayOrganization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayOrganizationSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+				DeletionPolicy:    redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy,
+			},
+		},
+	}
+
+	r := ReconcileQuayOrganization{ResourceReconciler: testutil.NewResourceReconciler(quayOrganization, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	// The finalizer is added before the organization is created
+	_, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.True(t, util.HasFinalizer(quayOrganization, quayapi.QuayResourceFinalizer))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Contains(t, organizations, name)
+
+	now := metav1.Now()
+	quayOrganization.DeletionTimestamp = &now
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), quayOrganization))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, organizations, name)
+	assert.False(t, util.HasFinalizer(quayOrganization, quayapi.QuayResourceFinalizer))
+}
+
+func TestReconcileDeletionWaitsForQuayEcosystem(t *testing.T) {
+	testutil.SetupLogging()
+
+	organizations := map[string]qclient.Organization{name: {Name: name}}
+	server := newQuayServer(organizations)
+	defer server.Close()
+
+	now := metav1.Now()
+
+	quayOrganization := &redhatcopv1alpha1.QuayOrganization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			Finalizers:        []string{quayapi.QuayResourceFinalizer},
+			DeletionTimestamp: &now,
+		},
+		Spec: redhatcopv1alpha1.QuayOrganizationSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+				DeletionPolicy:    redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy,
+			},
+		},
+	}
+
+	quayEcosystem := testutil.NewReadyQuayEcosystem(t, namespace, server)
+	quayEcosystem.Status.SetupComplete = false
+
+	r := ReconcileQuayOrganization{ResourceReconciler: testutil.NewResourceReconciler(quayOrganization, quayEcosystem, testutil.NewQuayAPITokenSecret(namespace))}
+
+	// The organization is deleted once the QuayEcosystem becomes ready again
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.NotReadyRequeuePeriod, result.RequeueAfter)
+	assert.Contains(t, organizations, name)
+	assert.True(t, util.HasFinalizer(quayOrganization, quayapi.QuayResourceFinalizer))
+
+	// The organization is left behind when the QuayEcosystem is gone
+	assert.NoError(t, r.ReconcilerBase.GetClient().Delete(context.TODO(), quayEcosystem))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Contains(t, organizations, name)
+	assert.False(t, util.HasFinalizer(quayOrganization, quayapi.QuayResourceFinalizer))
+}
+
+func TestReconcileWaitsForAPIToken(t *testing.T) {
+	testutil.SetupLogging()
+
+	organizations := map[string]qclient.Organization{}
+	server := newQuayServer(organizations)
+	defer server.Close()
+
+	quayOrganization := &redhatcopv1alpha1.QuayOrganization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayOrganizationSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+		},
+	}
+
+	r := ReconcileQuayOrganization{ResourceReconciler: testutil.NewResourceReconciler(quayOrganization, testutil.NewReadyQuayEcosystem(t, namespace, server))}
+
+	// The organization is created once the API token has been minted
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.NotReadyRequeuePeriod, result.RequeueAfter)
+	assert.NotContains(t, organizations, name)
+
+	assert.NoError(t, r.ReconcilerBase.GetClient().Create(context.TODO(), testutil.NewQuayAPITokenSecret(namespace)))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayOrganization)
+
+	assert.NoError(t, err)
+	assert.Contains(t, organizations, name)
+}
@@ -0,0 +1,144 @@
+package quayrepository
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+)
+
+// permissionClient abstracts the endpoints managing either user or team permissions on a repository
+type permissionClient struct {
+	get    func(ctx context.Context, namespace string, name string) (*http.Response, qclient.RepositoryPermissions, error)
+	set    func(ctx context.Context, namespace string, name string, entity string, permission qclient.RepositoryPermissionRequest) (*http.Response, qclient.RepositoryPermission, error)
+	delete func(ctx context.Context, namespace string, name string, entity string) (*http.Response, error)
+}
+
+// syncPermissions grants the permissions declared in the specification and revokes those that are not declared
+// Kinds of permissions that are omitted from the specification are left untouched
+func syncPermissions(quayClient *qclient.QuayClient, quayRepository *redhatcopv1alpha1.QuayRepository) error {
+
+	manageUsers := quayRepository.Spec.UserPermissions != nil
+	manageRobots := quayRepository.Spec.RobotPermissions != nil
+
+	// Robot accounts are granted permissions through the user endpoints
+	if manageUsers || manageRobots {
+
+		desired := map[string]redhatcopv1alpha1.QuayRepositoryRole{}
+
+		for _, permission := range quayRepository.Spec.UserPermissions {
+			desired[permission.Name] = permission.Role
+		}
+
+		for _, permission := range quayRepository.Spec.RobotPermissions {
+			desired[quayRepository.GetRobotAccountName(permission.Name)] = permission.Role
+		}
+
+		isManaged := func(permission qclient.RepositoryPermission) bool {
+
+			// The account used by the operator retains its access to the repository
+			if permission.Name == quayClient.Username {
+				return false
+			}
+
+			if permission.IsRobot {
+				return manageRobots
+			}
+
+			return manageUsers
+		}
+
+		err := syncPermissionsOfKind(permissionClient{
+			get:    quayClient.GetRepositoryUserPermissions,
+			set:    quayClient.SetRepositoryUserPermission,
+			delete: quayClient.DeleteRepositoryUserPermission,
+		}, quayRepository, desired, isManaged)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if quayRepository.Spec.TeamPermissions != nil {
+
+		desired := map[string]redhatcopv1alpha1.QuayRepositoryRole{}
+
+		for _, permission := range quayRepository.Spec.TeamPermissions {
+			desired[permission.Name] = permission.Role
+		}
+
+		err := syncPermissionsOfKind(permissionClient{
+			get:    quayClient.GetRepositoryTeamPermissions,
+			set:    quayClient.SetRepositoryTeamPermission,
+			delete: quayClient.DeleteRepositoryTeamPermission,
+		}, quayRepository, desired, func(qclient.RepositoryPermission) bool { return true })
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncPermissionsOfKind reconciles the permissions reported by Quay with the desired roles
+func syncPermissionsOfKind(permissionClient permissionClient, quayRepository *redhatcopv1alpha1.QuayRepository, desired map[string]redhatcopv1alpha1.QuayRepositoryRole, isManaged func(qclient.RepositoryPermission) bool) error {
+
+	namespace := quayRepository.Spec.Namespace
+	name := quayRepository.GetRepositoryName()
+
+	_, existing, err := permissionClient.get(context.TODO(), namespace, name)
+
+	if err != nil {
+		return err
+	}
+
+	for entity, permission := range existing.Permissions {
+
+		if _, found := desired[entity]; found || !isManaged(permission) {
+			continue
+		}
+
+		logging.Log.Info("Revoking Repository Permission", "Namespace", quayRepository.Namespace, "Name", quayRepository.Name, "Repository", quayRepository.GetFullRepositoryName(), "Entity", entity)
+
+		_, err := permissionClient.delete(context.TODO(), namespace, name, entity)
+
+		if err != nil && !qclient.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for entity, role := range desired {
+
+		if permission, found := existing.Permissions[entity]; found && strings.EqualFold(permission.Role, string(role)) {
+			continue
+		}
+
+		logging.Log.Info("Granting Repository Permission", "Namespace", quayRepository.Namespace, "Name", quayRepository.Name, "Repository", quayRepository.GetFullRepositoryName(), "Entity", entity, "Role", role)
+
+		_, _, err := permissionClient.set(context.TODO(), namespace, name, entity, qclient.RepositoryPermissionRequest{
+			Role: string(role),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitRepositoryName returns the namespace and name of a full repository name
+func splitRepositoryName(fullName string) (string, string) {
+
+	parts := strings.SplitN(fullName, "/", 2)
+
+	if len(parts) != 2 {
+		return "", fullName
+	}
+
+	return parts[0], parts[1]
+}
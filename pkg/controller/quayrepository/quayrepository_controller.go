@@ -0,0 +1,192 @@
+package quayrepository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Add creates a new QuayRepository Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayRepository {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quayrepository-controller"))
+
+	return &ReconcileQuayRepository{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quayrepository-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayRepository
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayRepository{}}, &handler.EnqueueRequestForObject{}, util.ResourceGenerationOrFinalizerChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayRepository{}, func() runtime.Object {
+		return &redhatcopv1alpha1.QuayRepositoryList{}
+	})
+}
+
+// blank assignment to verify that ReconcileQuayRepository implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayRepository{}
+
+// ReconcileQuayRepository reconciles a QuayRepository object
+type ReconcileQuayRepository struct {
+	quayapi.ResourceReconciler
+}
+
+// Reconcile synchronizes the repository in Quay with the QuayRepository
+func (r *ReconcileQuayRepository) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayRepository")
+
+	quayRepository := &redhatcopv1alpha1.QuayRepository{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayRepository)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsBeingDeleted(quayRepository) {
+		return r.ManageDeletion(quayRepository, func(quayInstance *quayapi.QuayInstance) error {
+			return deleteRepository(quayInstance.QuayClient, getManagedRepositoryName(quayRepository))
+		})
+	}
+
+	updated, err := r.ManageFinalizer(quayRepository)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	// Repositories cannot be renamed or moved
+	if quayRepository.Status.RepositoryName != "" && quayRepository.Status.RepositoryName != quayRepository.GetFullRepositoryName() {
+		return r.ManageError(quayRepository, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Repository %s cannot be renamed to %s", quayRepository.Status.RepositoryName, quayRepository.GetFullRepositoryName()))
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayRepository)
+	if err != nil {
+		return r.ManageError(quayRepository, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = syncRepository(quayInstance.QuayClient, quayRepository)
+	if err != nil {
+		return r.ManageError(quayRepository, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	quayRepository.Status.RepositoryName = quayRepository.GetFullRepositoryName()
+
+	err = syncPermissions(quayInstance.QuayClient, quayRepository)
+	if err != nil {
+		return r.ManageError(quayRepository, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	return r.ManageSuccess(quayRepository, "Repository Synchronized Successfully")
+}
+
+// syncRepository creates the repository or updates its description and visibility when they differ from the specification
+func syncRepository(quayClient *qclient.QuayClient, quayRepository *redhatcopv1alpha1.QuayRepository) error {
+
+	namespace := quayRepository.Spec.Namespace
+	name := quayRepository.GetRepositoryName()
+	visibility := quayRepository.GetVisibility()
+
+	_, repository, err := quayClient.GetRepository(context.TODO(), namespace, name)
+
+	if qclient.IsNotFound(err) {
+		logging.Log.Info("Creating Repository", "Namespace", quayRepository.Namespace, "Name", quayRepository.Name, "Repository", quayRepository.GetFullRepositoryName())
+
+		_, _, err := quayClient.CreateRepository(context.TODO(), qclient.RepositoryCreateRequest{
+			Namespace:   namespace,
+			Repository:  name,
+			Visibility:  string(visibility),
+			Description: quayRepository.Spec.Description,
+			RepoKind:    "image",
+		})
+
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if repository.Description != quayRepository.Spec.Description {
+		logging.Log.Info("Updating Repository Description", "Namespace", quayRepository.Namespace, "Name", quayRepository.Name, "Repository", quayRepository.GetFullRepositoryName())
+
+		_, _, err := quayClient.UpdateRepository(context.TODO(), namespace, name, qclient.RepositoryUpdateRequest{
+			Description: quayRepository.Spec.Description,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if repository.IsPublic != (visibility == redhatcopv1alpha1.PublicQuayRepositoryVisibility) {
+		logging.Log.Info("Updating Repository Visibility", "Namespace", quayRepository.Namespace, "Name", quayRepository.Name, "Repository", quayRepository.GetFullRepositoryName())
+
+		_, _, err := quayClient.ChangeRepositoryVisibility(context.TODO(), namespace, name, qclient.RepositoryVisibilityRequest{
+			Visibility: string(visibility),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteRepository deletes the repository from Quay unless it no longer exists
+func deleteRepository(quayClient *qclient.QuayClient, fullName string) error {
+
+	namespace, name := splitRepositoryName(fullName)
+
+	_, err := quayClient.DeleteRepository(context.TODO(), namespace, name)
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// getManagedRepositoryName returns the full name of the repository created in Quay
+func getManagedRepositoryName(quayRepository *redhatcopv1alpha1.QuayRepository) string {
+
+	if quayRepository.Status.RepositoryName != "" {
+		return quayRepository.Status.RepositoryName
+	}
+
+	return quayRepository.GetFullRepositoryName()
+}
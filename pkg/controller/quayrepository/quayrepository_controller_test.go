@@ -0,0 +1,213 @@
+package quayrepository
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var name = "example-quayrepository"
+var namespace = "quay-enterprise"
+var quayNamespace = "example"
+
+// fakeRepository is the state of a repository held by the fake Quay server
+type fakeRepository struct {
+	repository      qclient.Repository
+	userPermissions map[string]qclient.RepositoryPermission
+	teamPermissions map[string]qclient.RepositoryPermission
+}
+
+// newQuayServer returns a server implementing the repository endpoints of the Quay API backed by the provided repositories
+func newQuayServer(repositories map[string]*fakeRepository) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1/repository" {
+			request := qclient.RepositoryCreateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			repositories[request.Namespace+"/"+request.Repository] = &fakeRepository{
+				repository: qclient.Repository{
+					Namespace:   request.Namespace,
+					Name:        request.Repository,
+					Description: request.Description,
+					IsPublic:    request.Visibility == "public",
+				},
+				// The creator of a repository is granted admin access
+				userPermissions: map[string]qclient.RepositoryPermission{
+					constants.InitialQuaySuperuserDefaultUsername: {Name: constants.InitialQuaySuperuserDefaultUsername, Role: "admin"},
+				},
+				teamPermissions: map[string]qclient.RepositoryPermission{},
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(repositories[request.Namespace+"/"+request.Repository].repository)
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/repository/"), "/")
+		repository, found := repositories[parts[0]+"/"+parts[1]]
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_message": "Not Found"}`))
+			return
+		}
+
+		switch {
+		case len(parts) == 2 && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(repository.repository)
+		case len(parts) == 2 && r.Method == http.MethodPut:
+			request := qclient.RepositoryUpdateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			repository.repository.Description = request.Description
+			w.Write([]byte(`{"success": true}`))
+		case len(parts) == 2 && r.Method == http.MethodDelete:
+			delete(repositories, parts[0]+"/"+parts[1])
+			w.WriteHeader(http.StatusNoContent)
+		case len(parts) == 3 && parts[2] == "changevisibility":
+			request := qclient.RepositoryVisibilityRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			repository.repository.IsPublic = request.Visibility == "public"
+			w.Write([]byte(`{"success": true}`))
+		case len(parts) >= 4 && parts[2] == "permissions":
+			permissions := repository.userPermissions
+			if parts[3] == "team" {
+				permissions = repository.teamPermissions
+			}
+
+			entity := ""
+			if len(parts) == 5 {
+				entity = parts[4]
+			}
+
+			switch r.Method {
+			case http.MethodGet:
+				json.NewEncoder(w).Encode(qclient.RepositoryPermissions{Permissions: permissions})
+			case http.MethodPut:
+				request := qclient.RepositoryPermissionRequest{}
+				json.NewDecoder(r.Body).Decode(&request)
+				permissions[entity] = qclient.RepositoryPermission{Name: entity, Role: request.Role, IsRobot: strings.Contains(entity, "+")}
+				json.NewEncoder(w).Encode(permissions[entity])
+			case http.MethodDelete:
+				delete(permissions, entity)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func TestReconcileRepository(t *testing.T) {
+	testutil.SetupLogging()
+
+	repositories := map[string]*fakeRepository{}
+	server := newQuayServer(repositories)
+	defer server.Close()
+
+	fullName := quayNamespace + "/" + name
+
+	quayRepository := &redhatcopv1alpha1.QuayRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayRepositorySpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Namespace:   quayNamespace,
+			Visibility:  redhatcopv1alpha1.PublicQuayRepositoryVisibility,
+			Description: "Example Repository",
+			UserPermissions: []redhatcopv1alpha1.QuayRepositoryPermission{
+				{Name: "developer", Role: redhatcopv1alpha1.WriteQuayRepositoryRole},
+			},
+			RobotPermissions: []redhatcopv1alpha1.QuayRepositoryPermission{
+				{Name: "builder", Role: redhatcopv1alpha1.WriteQuayRepositoryRole},
+			},
+		},
+	}
+
+	r := ReconcileQuayRepository{ResourceReconciler: testutil.NewResourceReconciler(quayRepository, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepository)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Contains(t, repositories, fullName)
+	assert.True(t, repositories[fullName].repository.IsPublic)
+	assert.Equal(t, "Example Repository", repositories[fullName].repository.Description)
+	assert.Equal(t, fullName, quayRepository.Status.RepositoryName)
+
+	userPermissions := repositories[fullName].userPermissions
+	assert.Len(t, userPermissions, 3)
+	assert.Equal(t, "write", userPermissions["developer"].Role)
+	assert.Equal(t, "write", userPermissions[quayNamespace+"+builder"].Role)
+	assert.Contains(t, userPermissions, constants.InitialQuaySuperuserDefaultUsername)
+
+	syncedCondition, found := quayRepository.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionTrue, syncedCondition.Status)
+
+	// Drift in Quay is repaired
+	repositories[fullName].repository.IsPublic = false
+	repositories[fullName].repository.Description = "Changed"
+	userPermissions["developer"] = qclient.RepositoryPermission{Name: "developer", Role: "admin"}
+	userPermissions["intruder"] = qclient.RepositoryPermission{Name: "intruder", Role: "read"}
+	repositories[fullName].teamPermissions["owners"] = qclient.RepositoryPermission{Name: "owners", Role: "admin"}
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepository)
+
+	assert.NoError(t, err)
+	assert.True(t, repositories[fullName].repository.IsPublic)
+	assert.Equal(t, "Example Repository", repositories[fullName].repository.Description)
+	assert.Equal(t, "write", userPermissions["developer"].Role)
+	assert.NotContains(t, userPermissions, "intruder")
+
+	// Team permissions are not managed when omitted
+	assert.Contains(t, repositories[fullName].teamPermissions, "owners")
+}
+
+func TestReconcileRepositoryAPIError(t *testing.T) {
+	testutil.SetupLogging()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error_message": "Forbidden"}`))
+	}))
+	defer server.Close()
+
+	quayRepository := &redhatcopv1alpha1.QuayRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayRepositorySpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Namespace: quayNamespace,
+		},
+	}
+
+	r := ReconcileQuayRepository{ResourceReconciler: testutil.NewResourceReconciler(quayRepository, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	_, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepository)
+
+	assert.Error(t, err)
+
+	syncedCondition, found := quayRepository.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceSyncFailure), syncedCondition.Reason)
+	assert.Contains(t, syncedCondition.Message, "403")
+}
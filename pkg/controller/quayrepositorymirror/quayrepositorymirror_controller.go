@@ -0,0 +1,389 @@
+package quayrepositorymirror
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// mirrorRepositoryState is the state of a repository whose content is managed by the mirror workers
+	mirrorRepositoryState = "MIRROR"
+
+	// normalRepositoryState is the state of a repository whose content is pushed by users
+	normalRepositoryState = "NORMAL"
+
+	// tagGlobRuleKind is the kind of rule selecting the mirrored tags using a list of glob patterns
+	tagGlobRuleKind = "tag_glob_csv"
+
+	// syncStartDateFormat is the format of the dates of the mirror configuration in the Quay API
+	syncStartDateFormat = "2006-01-02T15:04:05Z"
+
+	// syncInProgressPollPeriod is the period at which the state of a running synchronization is polled from Quay
+	syncInProgressPollPeriod = 30 * time.Second
+)
+
+// Add creates a new QuayRepositoryMirror Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayRepositoryMirror {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quayrepositorymirror-controller"))
+
+	return &ReconcileQuayRepositoryMirror{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quayrepositorymirror-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayRepositoryMirror
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayRepositoryMirror{}}, &handler.EnqueueRequestForObject{}, util.ResourceGenerationOrFinalizerChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	newList := func() runtime.Object {
+		return &redhatcopv1alpha1.QuayRepositoryMirrorList{}
+	}
+
+	// Watch for changes to the Secrets containing the credentials of the external registries
+	err = quayapi.WatchReferencedSecrets(mgr, c, &redhatcopv1alpha1.QuayRepositoryMirror{}, newList, func(obj runtime.Object) []string {
+
+		quayRepositoryMirror, ok := obj.(*redhatcopv1alpha1.QuayRepositoryMirror)
+
+		if !ok || quayRepositoryMirror.Spec.CredentialsSecretName == "" {
+			return nil
+		}
+
+		return []string{quayRepositoryMirror.Spec.CredentialsSecretName}
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayRepositoryMirror{}, newList)
+}
+
+// blank assignment to verify that ReconcileQuayRepositoryMirror implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayRepositoryMirror{}
+
+// ReconcileQuayRepositoryMirror reconciles a QuayRepositoryMirror object
+type ReconcileQuayRepositoryMirror struct {
+	quayapi.ResourceReconciler
+}
+
+// registryCredentials contains the credentials used to access an external registry
+type registryCredentials struct {
+	username string
+	password string
+	checksum string
+}
+
+// Reconcile synchronizes the mirror configuration of the repository in Quay with the QuayRepositoryMirror and reports the state of its synchronization
+func (r *ReconcileQuayRepositoryMirror) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayRepositoryMirror")
+
+	quayRepositoryMirror := &redhatcopv1alpha1.QuayRepositoryMirror{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayRepositoryMirror)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsBeingDeleted(quayRepositoryMirror) {
+		return r.ManageDeletion(quayRepositoryMirror, func(quayInstance *quayapi.QuayInstance) error {
+			return disableRepositoryMirror(quayInstance.QuayClient, getManagedRepositoryName(quayRepositoryMirror))
+		})
+	}
+
+	updated, err := r.ManageFinalizer(quayRepositoryMirror)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	// The mirrored repository cannot be changed as the previous repository would be left in the mirror state
+	if quayRepositoryMirror.Status.RepositoryName != "" && quayRepositoryMirror.Status.RepositoryName != quayRepositoryMirror.GetFullRepositoryName() {
+		return r.ManageError(quayRepositoryMirror, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Mirrored repository %s cannot be changed to %s", quayRepositoryMirror.Status.RepositoryName, quayRepositoryMirror.GetFullRepositoryName()))
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayRepositoryMirror)
+	if err != nil {
+		return r.ManageError(quayRepositoryMirror, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	if !quayInstance.QuayEcosystem.Spec.Quay.EnableRepoMirroring {
+		return r.ManageError(quayRepositoryMirror, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Repository mirroring is not enabled in QuayEcosystem %s", quayInstance.QuayEcosystem.Name))
+	}
+
+	credentials, err := r.getRegistryCredentials(quayRepositoryMirror)
+	if err != nil {
+		return r.ManageError(quayRepositoryMirror, redhatcopv1alpha1.QuayResourceValidationFailure, err)
+	}
+
+	err = syncRepositoryState(quayInstance.QuayClient, quayRepositoryMirror)
+	if err != nil {
+		return r.ManageError(quayRepositoryMirror, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	quayRepositoryMirror.Status.RepositoryName = quayRepositoryMirror.GetFullRepositoryName()
+
+	mirror, err := syncRepositoryMirror(quayInstance.QuayClient, quayRepositoryMirror, credentials)
+	if err != nil {
+		return r.ManageError(quayRepositoryMirror, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	updateMirrorSyncStatus(quayRepositoryMirror, mirror)
+
+	result, err := r.ManageSuccess(quayRepositoryMirror, "Repository Mirror Synchronized Successfully")
+
+	// A running synchronization is polled more frequently to report its outcome
+	if err == nil && quayRepositoryMirror.Status.MirrorSyncStatus.IsSyncInProgress() {
+		result.RequeueAfter = syncInProgressPollPeriod
+	}
+
+	return result, err
+}
+
+// getRegistryCredentials returns the credentials of the external registry contained in the referenced Secret or nil when no Secret is referenced
+func (r *ReconcileQuayRepositoryMirror) getRegistryCredentials(quayRepositoryMirror *redhatcopv1alpha1.QuayRepositoryMirror) (*registryCredentials, error) {
+
+	if quayRepositoryMirror.Spec.CredentialsSecretName == "" {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: quayRepositoryMirror.Namespace, Name: quayRepositoryMirror.Spec.CredentialsSecretName}, secret)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("Credentials secret %s not found", quayRepositoryMirror.Spec.CredentialsSecretName)
+		}
+		return nil, err
+	}
+
+	for _, key := range constants.RequiredRepositoryMirrorCredentialKeys {
+		if _, found := secret.Data[key]; !found {
+			return nil, fmt.Errorf("Credentials secret %s is missing key %s", quayRepositoryMirror.Spec.CredentialsSecretName, key)
+		}
+	}
+
+	return &registryCredentials{
+		username: string(secret.Data[constants.RepositoryMirrorCredentialsUsernameKey]),
+		password: string(secret.Data[constants.RepositoryMirrorCredentialsPasswordKey]),
+		checksum: utils.Checksum(secret.Data),
+	}, nil
+}
+
+// syncRepositoryState hands the content of the existing repository over to the mirror workers
+func syncRepositoryState(quayClient *qclient.QuayClient, quayRepositoryMirror *redhatcopv1alpha1.QuayRepositoryMirror) error {
+
+	_, repository, err := quayClient.GetRepository(context.TODO(), quayRepositoryMirror.Spec.Namespace, quayRepositoryMirror.Spec.Repository)
+
+	if qclient.IsNotFound(err) {
+		return fmt.Errorf("Repository %s does not exist", quayRepositoryMirror.GetFullRepositoryName())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if repository.State == mirrorRepositoryState {
+		return nil
+	}
+
+	logging.Log.Info("Enabling Repository Mirroring", "Namespace", quayRepositoryMirror.Namespace, "Name", quayRepositoryMirror.Name, "Repository", quayRepositoryMirror.GetFullRepositoryName())
+
+	_, err = quayClient.ChangeRepositoryState(context.TODO(), quayRepositoryMirror.Spec.Namespace, quayRepositoryMirror.Spec.Repository, qclient.RepositoryStateRequest{
+		State: mirrorRepositoryState,
+	})
+
+	return err
+}
+
+// syncRepositoryMirror creates or updates the mirror configuration of the repository and returns the configuration reported by Quay
+func syncRepositoryMirror(quayClient *qclient.QuayClient, quayRepositoryMirror *redhatcopv1alpha1.QuayRepositoryMirror, credentials *registryCredentials) (qclient.RepositoryMirror, error) {
+
+	namespace := quayRepositoryMirror.Spec.Namespace
+	repository := quayRepositoryMirror.Spec.Repository
+
+	desired := qclient.RepositoryMirrorRequest{
+		IsEnabled:         !quayRepositoryMirror.Spec.Suspend,
+		ExternalReference: quayRepositoryMirror.Spec.ExternalReference,
+		ExternalRegistryConfig: qclient.RepositoryMirrorRegistryConfig{
+			VerifyTLS: quayRepositoryMirror.IsVerifyTLS(),
+		},
+		SyncInterval: int(quayRepositoryMirror.GetSyncInterval().Seconds()),
+		RootRule: qclient.RepositoryMirrorRule{
+			RuleKind:  tagGlobRuleKind,
+			RuleValue: quayRepositoryMirror.Spec.TagPatterns,
+		},
+		RobotUsername: quayRepositoryMirror.GetRobotAccountName(),
+	}
+
+	// Quay never returns the password so the credentials are only sent when they changed
+	username, password, checksum := "", "", ""
+	if credentials != nil {
+		username, password, checksum = credentials.username, credentials.password, credentials.checksum
+	}
+
+	_, mirror, err := quayClient.GetRepositoryMirror(context.TODO(), namespace, repository)
+
+	if qclient.IsNotFound(err) {
+		logging.Log.Info("Creating Repository Mirror", "Namespace", quayRepositoryMirror.Namespace, "Name", quayRepositoryMirror.Name, "Repository", quayRepositoryMirror.GetFullRepositoryName())
+
+		desired.SyncStartDate = time.Now().UTC().Format(syncStartDateFormat)
+
+		if credentials != nil {
+			desired.ExternalRegistryUsername = &username
+			desired.ExternalRegistryPassword = &password
+		}
+
+		_, err = quayClient.CreateRepositoryMirror(context.TODO(), namespace, repository, desired)
+
+		if err != nil {
+			return qclient.RepositoryMirror{}, err
+		}
+
+	} else {
+
+		if err != nil {
+			return qclient.RepositoryMirror{}, err
+		}
+
+		existingUsername := ""
+		if mirror.ExternalRegistryUsername != nil {
+			existingUsername = *mirror.ExternalRegistryUsername
+		}
+
+		credentialsChanged := existingUsername != username || quayRepositoryMirror.Status.CredentialsChecksum != checksum
+
+		if !credentialsChanged && mirror.IsEnabled == desired.IsEnabled && mirror.ExternalReference == desired.ExternalReference &&
+			mirror.ExternalRegistryConfig == desired.ExternalRegistryConfig && mirror.SyncInterval == desired.SyncInterval &&
+			mirror.RobotUsername == desired.RobotUsername && reflect.DeepEqual(mirror.RootRule, desired.RootRule) {
+			return mirror, nil
+		}
+
+		logging.Log.Info("Updating Repository Mirror", "Namespace", quayRepositoryMirror.Namespace, "Name", quayRepositoryMirror.Name, "Repository", quayRepositoryMirror.GetFullRepositoryName())
+
+		// Empty credentials remove those previously configured
+		if credentialsChanged {
+			desired.ExternalRegistryUsername = &username
+			desired.ExternalRegistryPassword = &password
+		}
+
+		_, err = quayClient.UpdateRepositoryMirror(context.TODO(), namespace, repository, desired)
+
+		if err != nil {
+			return qclient.RepositoryMirror{}, err
+		}
+	}
+
+	quayRepositoryMirror.Status.CredentialsChecksum = checksum
+
+	_, mirror, err = quayClient.GetRepositoryMirror(context.TODO(), namespace, repository)
+
+	if err != nil {
+		return qclient.RepositoryMirror{}, err
+	}
+
+	return mirror, nil
+}
+
+// updateMirrorSyncStatus reports the state of the synchronization of the repository polled from Quay
+func updateMirrorSyncStatus(quayRepositoryMirror *redhatcopv1alpha1.QuayRepositoryMirror, mirror qclient.RepositoryMirror) {
+
+	syncStatus := redhatcopv1alpha1.QuayRepositoryMirrorSyncStatus(mirror.SyncStatus)
+
+	var nextSyncTime *metav1.Time
+	if syncStartDate, err := time.Parse(syncStartDateFormat, mirror.SyncStartDate); err == nil {
+		nextSyncTime = &metav1.Time{Time: syncStartDate}
+	}
+
+	// Quay schedules the next synchronization once a synchronization completes
+	previousNextSyncTime := quayRepositoryMirror.Status.NextMirrorSyncTime
+	rescheduled := previousNextSyncTime != nil && nextSyncTime != nil && !previousNextSyncTime.Equal(nextSyncTime)
+
+	completed := syncStatus == redhatcopv1alpha1.SuccessQuayRepositoryMirrorSyncStatus || syncStatus == redhatcopv1alpha1.FailQuayRepositoryMirrorSyncStatus
+
+	if completed && (quayRepositoryMirror.Status.MirrorSyncStatus.IsSyncInProgress() || rescheduled) {
+		now := metav1.Now()
+		quayRepositoryMirror.Status.LastMirrorSyncTime = &now
+	}
+
+	quayRepositoryMirror.Status.MirrorSyncStatus = syncStatus
+	quayRepositoryMirror.Status.NextMirrorSyncTime = nextSyncTime
+}
+
+// disableRepositoryMirror returns the repository to the normal state so that it is no longer mirrored unless it no longer exists
+func disableRepositoryMirror(quayClient *qclient.QuayClient, fullName string) error {
+
+	namespace, name := splitRepositoryName(fullName)
+
+	_, err := quayClient.ChangeRepositoryState(context.TODO(), namespace, name, qclient.RepositoryStateRequest{
+		State: normalRepositoryState,
+	})
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// getManagedRepositoryName returns the full name of the repository mirrored in Quay
+func getManagedRepositoryName(quayRepositoryMirror *redhatcopv1alpha1.QuayRepositoryMirror) string {
+
+	if quayRepositoryMirror.Status.RepositoryName != "" {
+		return quayRepositoryMirror.Status.RepositoryName
+	}
+
+	return quayRepositoryMirror.GetFullRepositoryName()
+}
+
+// splitRepositoryName returns the namespace and name of a full repository name
+func splitRepositoryName(fullName string) (string, string) {
+
+	parts := strings.SplitN(fullName, "/", 2)
+
+	if len(parts) != 2 {
+		return "", fullName
+	}
+
+	return parts[0], parts[1]
+}
@@ -0,0 +1,203 @@
+package quayrepositorymirror
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var name = "example-mirror"
+var namespace = "quay-enterprise"
+var credentialsSecretName = "example-mirror-credentials"
+
+// fakeQuay is the state held by the fake Quay server
+type fakeQuay struct {
+	state  string
+	mirror *qclient.RepositoryMirror
+	// password is the last password of the external registry provided to Quay
+	password string
+	updates  int
+}
+
+// newQuayServer returns a server implementing the repository state and mirror endpoints of the Quay API for the example/app repository
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		path := strings.TrimPrefix(r.URL.Path, "/api/v1/repository/example/app")
+
+		switch {
+		case path == "" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(qclient.Repository{Namespace: "example", Name: "app", State: quay.state})
+		case path == "/changestate":
+			request := qclient.RepositoryStateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			quay.state = request.State
+			json.NewEncoder(w).Encode(map[string]bool{"success": true})
+		case path == "/mirror" && r.Method == http.MethodGet:
+			if quay.mirror == nil {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error_message": "Not Found"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(quay.mirror)
+		case path == "/mirror":
+			request := qclient.RepositoryMirrorRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+
+			if quay.mirror == nil {
+				quay.mirror = &qclient.RepositoryMirror{SyncStatus: "NEVER_RUN", SyncStartDate: request.SyncStartDate}
+			} else {
+				quay.updates++
+			}
+
+			quay.mirror.IsEnabled = request.IsEnabled
+			quay.mirror.ExternalReference = request.ExternalReference
+			quay.mirror.ExternalRegistryConfig = request.ExternalRegistryConfig
+			quay.mirror.SyncInterval = request.SyncInterval
+			quay.mirror.RootRule = request.RootRule
+			quay.mirror.RobotUsername = request.RobotUsername
+
+			if request.ExternalRegistryUsername != nil {
+				quay.mirror.ExternalRegistryUsername = request.ExternalRegistryUsername
+				quay.password = *request.ExternalRegistryPassword
+			}
+
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+// enableRepoMirroring enables repository mirroring in the effective specification of Quay
+func enableRepoMirroring(quay *redhatcopv1alpha1.Quay) {
+	quay.EnableRepoMirroring = true
+}
+
+func newQuayRepositoryMirror() *redhatcopv1alpha1.QuayRepositoryMirror {
+	return &redhatcopv1alpha1.QuayRepositoryMirror{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayRepositoryMirrorSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Namespace:             "example",
+			Repository:            "app",
+			ExternalReference:     "quay.io/coreos/etcd",
+			TagPatterns:           []string{"latest", "v3.*"},
+			RobotAccount:          "mirror",
+			CredentialsSecretName: credentialsSecretName,
+		},
+	}
+}
+
+func newCredentialsSecret(password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      credentialsSecretName,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("mirror-user"),
+			"password": []byte(password),
+		},
+	}
+}
+
+func TestReconcileRepositoryMirror(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{state: "NORMAL"}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayRepositoryMirror := newQuayRepositoryMirror()
+
+	r := ReconcileQuayRepositoryMirror{ResourceReconciler: testutil.NewResourceReconciler(quayRepositoryMirror, testutil.NewReadyQuayEcosystem(t, namespace, server, enableRepoMirroring), testutil.NewQuayAPITokenSecret(namespace), newCredentialsSecret("initial"))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepositoryMirror)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Equal(t, "MIRROR", quay.state)
+	assert.NotNil(t, quay.mirror)
+	assert.True(t, quay.mirror.IsEnabled)
+	assert.True(t, quay.mirror.ExternalRegistryConfig.VerifyTLS)
+	assert.Equal(t, "example+mirror", quay.mirror.RobotUsername)
+	assert.Equal(t, []string{"latest", "v3.*"}, quay.mirror.RootRule.RuleValue)
+	assert.Equal(t, 86400, quay.mirror.SyncInterval)
+	assert.Equal(t, "initial", quay.password)
+	assert.Equal(t, "example/app", quayRepositoryMirror.Status.RepositoryName)
+	assert.Equal(t, redhatcopv1alpha1.NeverRunQuayRepositoryMirrorSyncStatus, quayRepositoryMirror.Status.MirrorSyncStatus)
+	assert.NotNil(t, quayRepositoryMirror.Status.NextMirrorSyncTime)
+	assert.Nil(t, quayRepositoryMirror.Status.LastMirrorSyncTime)
+
+	// An unchanged configuration is not updated
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepositoryMirror)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, quay.updates)
+
+	// A running synchronization is polled until it completes
+	quay.mirror.SyncStatus = "SYNCING"
+
+	result, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepositoryMirror)
+
+	assert.NoError(t, err)
+	assert.Equal(t, syncInProgressPollPeriod, result.RequeueAfter)
+
+	quay.mirror.SyncStatus = "SUCCESS"
+
+	result, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepositoryMirror)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Equal(t, redhatcopv1alpha1.SuccessQuayRepositoryMirrorSyncStatus, quayRepositoryMirror.Status.MirrorSyncStatus)
+	assert.NotNil(t, quayRepositoryMirror.Status.LastMirrorSyncTime)
+
+	// Changed credentials are provided to Quay
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), newCredentialsSecret("rotated")))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepositoryMirror)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, quay.updates)
+	assert.Equal(t, "rotated", quay.password)
+}
+
+func TestReconcileRepositoryMirrorNotEnabled(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{state: "NORMAL"}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayRepositoryMirror := newQuayRepositoryMirror()
+
+	r := ReconcileQuayRepositoryMirror{ResourceReconciler: testutil.NewResourceReconciler(quayRepositoryMirror, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace), newCredentialsSecret("initial"))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRepositoryMirror)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Equal(t, "NORMAL", quay.state)
+	assert.Nil(t, quay.mirror)
+
+	syncedCondition, found := quayRepositoryMirror.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceValidationFailure), syncedCondition.Reason)
+}
@@ -0,0 +1,336 @@
+package quayrobotaccount
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Add creates a new QuayRobotAccount Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayRobotAccount {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quayrobotaccount-controller"))
+
+	return &ReconcileQuayRobotAccount{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quayrobotaccount-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayRobotAccount
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayRobotAccount{}}, &handler.EnqueueRequestForObject{}, regenerateTokenRequestedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the Secrets containing the credentials of robot accounts
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &redhatcopv1alpha1.QuayRobotAccount{},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayRobotAccount{}, func() runtime.Object {
+		return &redhatcopv1alpha1.QuayRobotAccountList{}
+	})
+}
+
+// regenerateTokenRequestedPredicate additionally fires an update event when the regeneration of the token is requested
+type regenerateTokenRequestedPredicate struct {
+	util.ResourceGenerationOrFinalizerChangedPredicate
+}
+
+// Update determines whether the specification, finalizers or token regeneration annotation changed
+func (p regenerateTokenRequestedPredicate) Update(e event.UpdateEvent) bool {
+
+	if p.ResourceGenerationOrFinalizerChangedPredicate.Update(e) {
+		return true
+	}
+
+	if e.MetaOld == nil || e.MetaNew == nil {
+		return false
+	}
+
+	return e.MetaOld.GetAnnotations()[constants.RegenerateTokenAnnotationKey] != e.MetaNew.GetAnnotations()[constants.RegenerateTokenAnnotationKey]
+}
+
+// blank assignment to verify that ReconcileQuayRobotAccount implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayRobotAccount{}
+
+// ReconcileQuayRobotAccount reconciles a QuayRobotAccount object
+type ReconcileQuayRobotAccount struct {
+	quayapi.ResourceReconciler
+}
+
+// Reconcile synchronizes the robot account in Quay with the QuayRobotAccount and publishes its credentials
+func (r *ReconcileQuayRobotAccount) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayRobotAccount")
+
+	quayRobotAccount := &redhatcopv1alpha1.QuayRobotAccount{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayRobotAccount)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsBeingDeleted(quayRobotAccount) {
+		return r.ManageDeletion(quayRobotAccount, func(quayInstance *quayapi.QuayInstance) error {
+			return deleteRobotAccount(quayInstance.QuayClient, getManagedRobotAccountName(quayRobotAccount))
+		})
+	}
+
+	updated, err := r.ManageFinalizer(quayRobotAccount)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	// Robot accounts cannot be renamed or moved
+	if quayRobotAccount.Status.RobotAccountName != "" && quayRobotAccount.Status.RobotAccountName != quayRobotAccount.GetRobotAccountName() {
+		return r.ManageError(quayRobotAccount, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Robot account %s cannot be renamed to %s", quayRobotAccount.Status.RobotAccountName, quayRobotAccount.GetRobotAccountName()))
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayRobotAccount)
+	if err != nil {
+		return r.ManageError(quayRobotAccount, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	robotAccount, err := syncRobotAccount(quayInstance.QuayClient, quayRobotAccount)
+	if err != nil {
+		return r.ManageError(quayRobotAccount, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	quayRobotAccount.Status.RobotAccountName = quayRobotAccount.GetRobotAccountName()
+
+	err = syncRobotAccountPermissions(quayInstance.QuayClient, quayRobotAccount)
+	if err != nil {
+		return r.ManageError(quayRobotAccount, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = r.manageRobotAccountSecret(quayRobotAccount, quayInstance.Hostname, robotAccount)
+	if err != nil {
+		return r.ManageError(quayRobotAccount, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	return r.ManageSuccess(quayRobotAccount, "Robot Account Synchronized Successfully")
+}
+
+// syncRobotAccount creates the robot account or regenerates its token when requested and returns the robot account including its token
+func syncRobotAccount(quayClient *qclient.QuayClient, quayRobotAccount *redhatcopv1alpha1.QuayRobotAccount) (qclient.RobotAccount, error) {
+
+	organization := quayRobotAccount.Spec.Organization
+	shortName := quayRobotAccount.GetRobotAccountShortName()
+	regenerationRequest := quayRobotAccount.Annotations[constants.RegenerateTokenAnnotationKey]
+
+	_, robotAccount, err := quayClient.GetOrganizationRobot(context.TODO(), organization, shortName)
+
+	if qclient.IsNotFound(err) {
+		logging.Log.Info("Creating Robot Account", "Namespace", quayRobotAccount.Namespace, "Name", quayRobotAccount.Name, "Robot Account", quayRobotAccount.GetRobotAccountName())
+
+		_, robotAccount, err = quayClient.CreateOrganizationRobot(context.TODO(), organization, shortName, qclient.RobotAccountCreateRequest{
+			Description: quayRobotAccount.Spec.Description,
+		})
+
+		if err != nil {
+			return qclient.RobotAccount{}, err
+		}
+
+		// A new robot account already has a fresh token
+		quayRobotAccount.Status.TokenRegenerationRequest = regenerationRequest
+
+		return robotAccount, nil
+	}
+
+	if err != nil {
+		return qclient.RobotAccount{}, err
+	}
+
+	if regenerationRequest != "" && regenerationRequest != quayRobotAccount.Status.TokenRegenerationRequest {
+		logging.Log.Info("Regenerating Robot Account Token", "Namespace", quayRobotAccount.Namespace, "Name", quayRobotAccount.Name, "Robot Account", quayRobotAccount.GetRobotAccountName())
+
+		_, robotAccount, err = quayClient.RegenerateOrganizationRobotToken(context.TODO(), organization, shortName)
+
+		if err != nil {
+			return qclient.RobotAccount{}, err
+		}
+
+		quayRobotAccount.Status.TokenRegenerationRequest = regenerationRequest
+	}
+
+	return robotAccount, nil
+}
+
+// syncRobotAccountPermissions grants the repository permissions declared in the specification and revokes those that are not declared
+func syncRobotAccountPermissions(quayClient *qclient.QuayClient, quayRobotAccount *redhatcopv1alpha1.QuayRobotAccount) error {
+
+	if quayRobotAccount.Spec.RepositoryPermissions == nil {
+		return nil
+	}
+
+	organization := quayRobotAccount.Spec.Organization
+	robotAccountName := quayRobotAccount.GetRobotAccountName()
+
+	desired := map[string]redhatcopv1alpha1.QuayRepositoryRole{}
+
+	for _, permission := range quayRobotAccount.Spec.RepositoryPermissions {
+		desired[permission.Repository] = permission.Role
+	}
+
+	_, existing, err := quayClient.GetOrganizationRobotPermissions(context.TODO(), organization, quayRobotAccount.GetRobotAccountShortName())
+
+	if err != nil {
+		return err
+	}
+
+	existingRoles := map[string]string{}
+
+	for _, permission := range existing.Permissions {
+
+		existingRoles[permission.Repository.Name] = permission.Role
+
+		if _, found := desired[permission.Repository.Name]; found {
+			continue
+		}
+
+		logging.Log.Info("Revoking Robot Account Permission", "Namespace", quayRobotAccount.Namespace, "Name", quayRobotAccount.Name, "Robot Account", robotAccountName, "Repository", permission.Repository.Name)
+
+		_, err := quayClient.DeleteRepositoryUserPermission(context.TODO(), organization, permission.Repository.Name, robotAccountName)
+
+		if err != nil && !qclient.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for repository, role := range desired {
+
+		if existingRoles[repository] == string(role) {
+			continue
+		}
+
+		logging.Log.Info("Granting Robot Account Permission", "Namespace", quayRobotAccount.Namespace, "Name", quayRobotAccount.Name, "Robot Account", robotAccountName, "Repository", repository, "Role", role)
+
+		_, _, err := quayClient.SetRepositoryUserPermission(context.TODO(), organization, repository, robotAccountName, qclient.RepositoryPermissionRequest{
+			Role: string(role),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// manageRobotAccountSecret publishes the credentials of the robot account as a pull secret for the Quay registry
+func (r *ReconcileQuayRobotAccount) manageRobotAccountSecret(quayRobotAccount *redhatcopv1alpha1.QuayRobotAccount, hostname string, robotAccount qclient.RobotAccount) error {
+
+	secretName := quayRobotAccount.GetSecretName()
+
+	// The Secret previously containing the credentials is removed when another Secret is requested
+	if quayRobotAccount.Status.SecretName != "" && quayRobotAccount.Status.SecretName != secretName {
+
+		err := r.ReconcilerBase.DeleteResource(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      quayRobotAccount.Status.SecretName,
+				Namespace: quayRobotAccount.Namespace,
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	secret, err := resources.GetDockerConfigSecretDefinition(metav1.ObjectMeta{
+		Name:      secretName,
+		Namespace: quayRobotAccount.Namespace,
+		Labels:    quayRobotAccount.Labels,
+	}, hostname, robotAccount.Name, robotAccount.Token)
+
+	if err != nil {
+		return err
+	}
+
+	err = r.ReconcilerBase.CreateOrUpdateResource(quayRobotAccount, quayRobotAccount.Namespace, secret)
+
+	if err != nil {
+		return err
+	}
+
+	quayRobotAccount.Status.SecretName = secretName
+
+	return nil
+}
+
+// deleteRobotAccount deletes the robot account from Quay unless it no longer exists
+func deleteRobotAccount(quayClient *qclient.QuayClient, robotAccountName string) error {
+
+	organization, shortName := splitRobotAccountName(robotAccountName)
+
+	_, err := quayClient.DeleteOrganizationRobot(context.TODO(), organization, shortName)
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// getManagedRobotAccountName returns the full name of the robot account created in Quay
+func getManagedRobotAccountName(quayRobotAccount *redhatcopv1alpha1.QuayRobotAccount) string {
+
+	if quayRobotAccount.Status.RobotAccountName != "" {
+		return quayRobotAccount.Status.RobotAccountName
+	}
+
+	return quayRobotAccount.GetRobotAccountName()
+}
+
+// splitRobotAccountName returns the organization and short name of a full robot account name
+func splitRobotAccountName(robotAccountName string) (string, string) {
+
+	parts := strings.SplitN(robotAccountName, "+", 2)
+
+	if len(parts) != 2 {
+		return "", robotAccountName
+	}
+
+	return parts[0], parts[1]
+}
@@ -0,0 +1,206 @@
+package quayrobotaccount
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var name = "example-robot"
+var namespace = "quay-enterprise"
+var organization = "example"
+
+// fakeQuay is the state held by the fake Quay server
+type fakeQuay struct {
+	robots map[string]qclient.RobotAccount
+	// permissions contains the role of each robot account by repository
+	permissions map[string]map[string]string
+	tokens      int
+}
+
+// newQuayServer returns a server implementing the robot account endpoints of the Quay API
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		newToken := func() string {
+			quay.tokens++
+			return fmt.Sprintf("token-%d", quay.tokens)
+		}
+
+		if strings.HasPrefix(r.URL.Path, "/api/v1/organization/") {
+			parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/organization/"), "/")
+			robotName := parts[0] + "+" + parts[2]
+			robot, found := quay.robots[robotName]
+
+			switch {
+			case len(parts) == 3 && r.Method == http.MethodPut:
+				quay.robots[robotName] = qclient.RobotAccount{Name: robotName, Token: newToken()}
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(quay.robots[robotName])
+			case !found:
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error_message": "Not Found"}`))
+			case len(parts) == 3 && r.Method == http.MethodGet:
+				json.NewEncoder(w).Encode(robot)
+			case len(parts) == 3 && r.Method == http.MethodDelete:
+				delete(quay.robots, robotName)
+				w.WriteHeader(http.StatusNoContent)
+			case parts[3] == "regenerate":
+				robot.Token = newToken()
+				quay.robots[robotName] = robot
+				json.NewEncoder(w).Encode(robot)
+			case parts[3] == "permissions":
+				permissions := qclient.RobotAccountPermissions{Permissions: []qclient.RobotAccountPermission{}}
+				for repository, roles := range quay.permissions {
+					if role, found := roles[robotName]; found {
+						permissions.Permissions = append(permissions.Permissions, qclient.RobotAccountPermission{
+							Repository: qclient.RobotAccountPermissionRepository{Name: repository},
+							Role:       role,
+						})
+					}
+				}
+				json.NewEncoder(w).Encode(permissions)
+			}
+			return
+		}
+
+		// /api/v1/repository/{namespace}/{repository}/permissions/user/{username}
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/repository/"), "/")
+		repository, username := parts[1], parts[4]
+
+		if quay.permissions[repository] == nil {
+			quay.permissions[repository] = map[string]string{}
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			request := qclient.RepositoryPermissionRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			quay.permissions[repository][username] = request.Role
+			json.NewEncoder(w).Encode(qclient.RepositoryPermission{Name: username, Role: request.Role})
+		case http.MethodDelete:
+			delete(quay.permissions[repository], username)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+// getDockerConfigAuth returns the credentials for the registry contained in the pull secret of the robot account
+func getDockerConfigAuth(t *testing.T, r *ReconcileQuayRobotAccount, secretName string, registry string) map[string]string {
+
+	secret := &corev1.Secret{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: secretName}, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+
+	dockerConfig := map[string]map[string]map[string]string{}
+	assert.NoError(t, json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &dockerConfig))
+
+	return dockerConfig["auths"][registry]
+}
+
+func TestReconcileRobotAccount(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{robots: map[string]qclient.RobotAccount{}, permissions: map[string]map[string]string{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayEcosystem := testutil.NewReadyQuayEcosystem(t, namespace, server)
+	robotAccountName := organization + "+example_robot"
+
+	quayRobotAccount := &redhatcopv1alpha1.QuayRobotAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayRobotAccountSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Organization: organization,
+			RepositoryPermissions: []redhatcopv1alpha1.QuayRobotAccountPermission{
+				{Repository: "app", Role: redhatcopv1alpha1.ReadQuayRepositoryRole},
+			},
+		},
+	}
+
+	r := ReconcileQuayRobotAccount{ResourceReconciler: testutil.NewResourceReconciler(quayRobotAccount, quayEcosystem, testutil.NewQuayAPITokenSecret(namespace))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRobotAccount)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Contains(t, quay.robots, robotAccountName)
+	assert.Equal(t, "read", quay.permissions["app"][robotAccountName])
+	assert.Equal(t, robotAccountName, quayRobotAccount.Status.RobotAccountName)
+	assert.Equal(t, name, quayRobotAccount.Status.SecretName)
+
+	auth := getDockerConfigAuth(t, &r, name, quayEcosystem.Status.Hostname)
+	assert.Equal(t, robotAccountName, auth["username"])
+	assert.Equal(t, "token-1", auth["password"])
+
+	// Undeclared permissions are revoked
+	quay.permissions["other"] = map[string]string{robotAccountName: "admin"}
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRobotAccount)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, quay.permissions["other"], robotAccountName)
+	assert.Equal(t, 1, quay.tokens)
+
+	// The token is regenerated once when the annotation changes
+	quayRobotAccount.Annotations = map[string]string{constants.RegenerateTokenAnnotationKey: "1"}
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), quayRobotAccount))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRobotAccount)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1", quayRobotAccount.Status.TokenRegenerationRequest)
+	assert.Equal(t, "token-2", getDockerConfigAuth(t, &r, name, quayEcosystem.Status.Hostname)["password"])
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayRobotAccount)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, quay.tokens)
+}
+
+func TestRegenerateTokenRequestedPredicate(t *testing.T) {
+
+	quayRobotAccount := &redhatcopv1alpha1.QuayRobotAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       namespace,
+			ResourceVersion: "1",
+			Generation:      1,
+		},
+	}
+
+	statusUpdated := quayRobotAccount.DeepCopy()
+	statusUpdated.ResourceVersion = "2"
+	statusUpdated.Status.SecretName = name
+
+	regenerationRequested := quayRobotAccount.DeepCopy()
+	regenerationRequested.ResourceVersion = "2"
+	regenerationRequested.Annotations = map[string]string{constants.RegenerateTokenAnnotationKey: "1"}
+
+	p := regenerateTokenRequestedPredicate{}
+
+	assert.False(t, p.Update(event.UpdateEvent{MetaOld: quayRobotAccount, ObjectOld: quayRobotAccount, MetaNew: statusUpdated, ObjectNew: statusUpdated}))
+	assert.True(t, p.Update(event.UpdateEvent{MetaOld: quayRobotAccount, ObjectOld: quayRobotAccount, MetaNew: regenerationRequested, ObjectNew: regenerationRequested}))
+}
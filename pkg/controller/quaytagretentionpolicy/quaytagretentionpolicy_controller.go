@@ -0,0 +1,281 @@
+package quaytagretentionpolicy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// maxReportedPrunedTags is the maximum number of pruned tags listed in the status to bound its size
+	maxReportedPrunedTags = 100
+)
+
+// Add creates a new QuayTagRetentionPolicy Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayTagRetentionPolicy {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quaytagretentionpolicy-controller"))
+
+	return &ReconcileQuayTagRetentionPolicy{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quaytagretentionpolicy-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayTagRetentionPolicy
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayTagRetentionPolicy{}}, &handler.EnqueueRequestForObject{}, util.ResourceGenerationOrFinalizerChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayTagRetentionPolicy{}, func() runtime.Object {
+		return &redhatcopv1alpha1.QuayTagRetentionPolicyList{}
+	})
+}
+
+// blank assignment to verify that ReconcileQuayTagRetentionPolicy implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayTagRetentionPolicy{}
+
+// ReconcileQuayTagRetentionPolicy reconciles a QuayTagRetentionPolicy object
+type ReconcileQuayTagRetentionPolicy struct {
+	quayapi.ResourceReconciler
+}
+
+// retentionRules contains the validated rules of a QuayTagRetentionPolicy
+type retentionRules struct {
+	keepLast  int
+	maxAge    *time.Duration
+	protected *regexp.Regexp
+}
+
+// Reconcile prunes the expired tags of the repositories targeted by the QuayTagRetentionPolicy each time a run of the policy is due
+func (r *ReconcileQuayTagRetentionPolicy) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayTagRetentionPolicy")
+
+	quayTagRetentionPolicy := &redhatcopv1alpha1.QuayTagRetentionPolicy{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayTagRetentionPolicy)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Pruned tags cannot be restored so nothing is left to clean up in Quay
+	if util.IsBeingDeleted(quayTagRetentionPolicy) {
+		return reconcile.Result{}, nil
+	}
+
+	rules, err := getRetentionRules(quayTagRetentionPolicy)
+	if err != nil {
+		return r.ManageError(quayTagRetentionPolicy, redhatcopv1alpha1.QuayResourceValidationFailure, err)
+	}
+
+	if wait := untilNextRun(quayTagRetentionPolicy, time.Now()); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayTagRetentionPolicy)
+	if err != nil {
+		return r.ManageError(quayTagRetentionPolicy, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	prunedTags, err := pruneTags(quayInstance.QuayClient, quayTagRetentionPolicy, rules, time.Now())
+	if err != nil {
+		return r.ManageError(quayTagRetentionPolicy, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	updatePruneStatus(quayTagRetentionPolicy, prunedTags)
+
+	message := fmt.Sprintf("Pruned %d Tags Successfully", len(prunedTags))
+	if quayTagRetentionPolicy.Spec.DryRun {
+		message = fmt.Sprintf("%d Tags Would Be Pruned", len(prunedTags))
+	}
+
+	result, err := r.ManageSuccess(quayTagRetentionPolicy, message)
+
+	// The policy is not run again before its interval elapsed
+	if err == nil {
+		result.RequeueAfter = quayTagRetentionPolicy.GetInterval()
+	}
+
+	return result, err
+}
+
+// getRetentionRules validates the rules of the QuayTagRetentionPolicy
+func getRetentionRules(quayTagRetentionPolicy *redhatcopv1alpha1.QuayTagRetentionPolicy) (*retentionRules, error) {
+
+	spec := quayTagRetentionPolicy.Spec
+
+	if spec.KeepLast == nil && spec.MaxAge == nil {
+		return nil, fmt.Errorf("At least one of keepLast or maxAge must be specified")
+	}
+
+	if _, err := path.Match(quayTagRetentionPolicy.GetRepositoryPattern(), ""); err != nil {
+		return nil, fmt.Errorf("Invalid repository pattern %s: %s", spec.RepositoryPattern, err.Error())
+	}
+
+	if quayTagRetentionPolicy.GetInterval() <= 0 {
+		return nil, fmt.Errorf("Interval must be positive")
+	}
+
+	rules := &retentionRules{}
+
+	if spec.KeepLast != nil {
+		rules.keepLast = int(*spec.KeepLast)
+	}
+
+	if spec.MaxAge != nil {
+		rules.maxAge = &spec.MaxAge.Duration
+	}
+
+	if spec.ProtectedTagPattern != "" {
+		protected, err := regexp.Compile(spec.ProtectedTagPattern)
+
+		if err != nil {
+			return nil, fmt.Errorf("Invalid protected tag pattern %s: %s", spec.ProtectedTagPattern, err.Error())
+		}
+
+		rules.protected = protected
+	}
+
+	return rules, nil
+}
+
+// untilNextRun returns the time remaining before the next run of the policy. Changes to the policy are applied immediately
+func untilNextRun(quayTagRetentionPolicy *redhatcopv1alpha1.QuayTagRetentionPolicy, now time.Time) time.Duration {
+
+	status := quayTagRetentionPolicy.Status
+
+	if status.NextRunTime == nil || status.ObservedGeneration != quayTagRetentionPolicy.GetGeneration() {
+		return 0
+	}
+
+	return status.NextRunTime.Sub(now)
+}
+
+// pruneTags deletes the expired tags of the repositories matching the policy, or only reports them in dry-run mode, and returns them in the form repository:tag
+func pruneTags(quayClient *qclient.QuayClient, quayTagRetentionPolicy *redhatcopv1alpha1.QuayTagRetentionPolicy, rules *retentionRules, now time.Time) ([]string, error) {
+
+	organization := quayTagRetentionPolicy.Spec.Organization
+
+	_, repositories, err := quayClient.GetAllRepositories(context.TODO(), organization)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list repositories of organization %s: %s", organization, err.Error())
+	}
+
+	prunedTags := []string{}
+
+	for _, repository := range repositories {
+
+		if matched, _ := path.Match(quayTagRetentionPolicy.GetRepositoryPattern(), repository.Name); !matched {
+			continue
+		}
+
+		_, tags, err := quayClient.GetAllRepositoryTags(context.TODO(), organization, repository.Name)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list tags of repository %s/%s: %s", organization, repository.Name, err.Error())
+		}
+
+		for _, tag := range selectExpiredTags(tags, rules, now) {
+
+			if !quayTagRetentionPolicy.Spec.DryRun {
+				logging.Log.Info("Pruning Tag", "Namespace", quayTagRetentionPolicy.Namespace, "Name", quayTagRetentionPolicy.Name, "Repository", organization+"/"+repository.Name, "Tag", tag.Name)
+
+				_, err := quayClient.DeleteRepositoryTag(context.TODO(), organization, repository.Name, tag.Name)
+
+				if err != nil && !qclient.IsNotFound(err) {
+					return nil, fmt.Errorf("Failed to delete tag %s of repository %s/%s: %s", tag.Name, organization, repository.Name, err.Error())
+				}
+			}
+
+			prunedTags = append(prunedTags, fmt.Sprintf("%s:%s", repository.Name, tag.Name))
+		}
+	}
+
+	return prunedTags, nil
+}
+
+// selectExpiredTags returns the tags violating the retention rules
+// Tags are ordered from the most recent and the KeepLast most recent unprotected tags are retained. The remaining tags expire once older than MaxAge, or immediately when MaxAge is not specified
+func selectExpiredTags(tags []qclient.Tag, rules *retentionRules, now time.Time) []qclient.Tag {
+
+	sorted := make([]qclient.Tag, len(tags))
+	copy(sorted, tags)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartTS > sorted[j].StartTS
+	})
+
+	expired := []qclient.Tag{}
+	kept := 0
+
+	for _, tag := range sorted {
+
+		if rules.protected != nil && rules.protected.MatchString(tag.Name) {
+			continue
+		}
+
+		if kept < rules.keepLast {
+			kept++
+			continue
+		}
+
+		if rules.maxAge != nil && now.Sub(time.Unix(tag.StartTS, 0)) <= *rules.maxAge {
+			continue
+		}
+
+		expired = append(expired, tag)
+	}
+
+	return expired
+}
+
+// updatePruneStatus reports the outcome of the run of the policy and schedules the next run
+func updatePruneStatus(quayTagRetentionPolicy *redhatcopv1alpha1.QuayTagRetentionPolicy, prunedTags []string) {
+
+	now := metav1.Now()
+	nextRunTime := metav1.NewTime(now.Add(quayTagRetentionPolicy.GetInterval()))
+
+	status := &quayTagRetentionPolicy.Status
+	status.LastRunTime = &now
+	status.NextRunTime = &nextRunTime
+	status.DryRun = quayTagRetentionPolicy.Spec.DryRun
+	status.PrunedTagCount = int32(len(prunedTags))
+
+	if len(prunedTags) > maxReportedPrunedTags {
+		prunedTags = prunedTags[:maxReportedPrunedTags]
+	}
+
+	status.PrunedTags = prunedTags
+}
@@ -0,0 +1,208 @@
+package quaytagretentionpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var name = "example-policy"
+var namespace = "quay-enterprise"
+
+// fakeQuay is the state held by the fake Quay server
+type fakeQuay struct {
+	// repositories contains the tags of the repositories of the example organization
+	repositories map[string][]qclient.Tag
+	deletions    int
+}
+
+// newQuayServer returns a server implementing the repository listing and tag endpoints of the Quay API for the example organization
+// Repositories and tags are returned one per page to exercise pagination
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		names := []string{}
+		for repository := range quay.repositories {
+			names = append(names, repository)
+		}
+		sort.Strings(names)
+
+		if r.URL.Path == "/api/v1/repository" {
+			index, _ := strconv.Atoi(r.URL.Query().Get("next_page"))
+			page := qclient.Repositories{Repositories: []qclient.Repository{}}
+			if r.URL.Query().Get("namespace") == "example" && index < len(names) {
+				page.Repositories = append(page.Repositories, qclient.Repository{Namespace: "example", Name: names[index]})
+				if index+1 < len(names) {
+					page.NextPage = strconv.Itoa(index + 1)
+				}
+			}
+			json.NewEncoder(w).Encode(page)
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/repository/example/"), "/")
+		tags, found := quay.repositories[parts[0]]
+
+		switch {
+		case !found || len(parts) < 2 || parts[1] != "tag":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodGet:
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			json.NewEncoder(w).Encode(qclient.Tags{Tags: tags[page-1 : page], Page: page, HasAdditional: page < len(tags)})
+		case r.Method == http.MethodDelete:
+			remaining := []qclient.Tag{}
+			for _, tag := range tags {
+				if tag.Name != parts[2] {
+					remaining = append(remaining, tag)
+				}
+			}
+			quay.repositories[parts[0]] = remaining
+			quay.deletions++
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+// newTags returns tags created the provided number of days ago
+func newTags(ages map[string]int) []qclient.Tag {
+
+	tags := []qclient.Tag{}
+	for tagName, days := range ages {
+		tags = append(tags, qclient.Tag{Name: tagName, StartTS: time.Now().Add(-time.Duration(days) * 24 * time.Hour).Unix()})
+	}
+
+	return tags
+}
+
+func tagNames(tags []qclient.Tag) []string {
+
+	names := []string{}
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	return names
+}
+
+func newQuayTagRetentionPolicy(dryRun bool) *redhatcopv1alpha1.QuayTagRetentionPolicy {
+
+	keepLast := int32(1)
+
+	return &redhatcopv1alpha1.QuayTagRetentionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayTagRetentionPolicySpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Organization:        "example",
+			RepositoryPattern:   "ci-*",
+			KeepLast:            &keepLast,
+			MaxAge:              &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			ProtectedTagPattern: "^latest$",
+			DryRun:              dryRun,
+		},
+	}
+}
+
+func TestSelectExpiredTags(t *testing.T) {
+
+	tags := newTags(map[string]int{"latest": 30, "v3": 20, "v2": 10, "v1": 5, "v0": 1})
+	maxAge := 7 * 24 * time.Hour
+
+	// Tags beyond the most recent unprotected tag expire once older than the maximum age
+	expired := selectExpiredTags(tags, &retentionRules{keepLast: 1, maxAge: &maxAge}, time.Now())
+	assert.ElementsMatch(t, []string{"v2", "v3", "latest"}, tagNames(expired))
+
+	// Protected tags are neither pruned nor counted
+	expired = selectExpiredTags(tags, &retentionRules{keepLast: 2, protected: regexp.MustCompile("^(latest|v0)$")}, time.Now())
+	assert.ElementsMatch(t, []string{"v3"}, tagNames(expired))
+
+	// Every tag older than the maximum age expires when no tag is kept
+	expired = selectExpiredTags(tags, &retentionRules{maxAge: &maxAge}, time.Now())
+	assert.ElementsMatch(t, []string{"v2", "v3", "latest"}, tagNames(expired))
+}
+
+func TestReconcileTagRetentionPolicy(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{repositories: map[string][]qclient.Tag{
+		"ci-app": newTags(map[string]int{"latest": 30, "build-1": 20, "build-2": 10, "build-3": 1}),
+		"app":    newTags(map[string]int{"build-1": 20}),
+	}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayTagRetentionPolicy := newQuayTagRetentionPolicy(true)
+
+	r := ReconcileQuayTagRetentionPolicy{ResourceReconciler: testutil.NewResourceReconciler(quayTagRetentionPolicy, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	// Dry runs only report the expired tags
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTagRetentionPolicy)
+
+	assert.NoError(t, err)
+	assert.Equal(t, redhatcopv1alpha1.DefaultQuayTagRetentionPolicyInterval, result.RequeueAfter)
+	assert.Equal(t, 0, quay.deletions)
+	assert.True(t, quayTagRetentionPolicy.Status.DryRun)
+	assert.Equal(t, int32(2), quayTagRetentionPolicy.Status.PrunedTagCount)
+	assert.ElementsMatch(t, []string{"ci-app:build-1", "ci-app:build-2"}, quayTagRetentionPolicy.Status.PrunedTags)
+	assert.NotNil(t, quayTagRetentionPolicy.Status.LastRunTime)
+	assert.NotNil(t, quayTagRetentionPolicy.Status.NextRunTime)
+
+	// The policy is not run again before its next run
+	result, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTagRetentionPolicy)
+
+	assert.NoError(t, err)
+	assert.True(t, result.RequeueAfter > 0 && result.RequeueAfter <= redhatcopv1alpha1.DefaultQuayTagRetentionPolicyInterval)
+
+	// Changes to the policy are applied immediately
+	quayTagRetentionPolicy.Spec.DryRun = false
+	quayTagRetentionPolicy.Generation++
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), quayTagRetentionPolicy))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTagRetentionPolicy)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, quay.deletions)
+	assert.False(t, quayTagRetentionPolicy.Status.DryRun)
+	assert.ElementsMatch(t, []string{"latest", "build-3"}, tagNames(quay.repositories["ci-app"]))
+	assert.Len(t, quay.repositories["app"], 1)
+}
+
+func TestReconcileTagRetentionPolicyValidation(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{repositories: map[string][]qclient.Tag{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayTagRetentionPolicy := newQuayTagRetentionPolicy(false)
+	quayTagRetentionPolicy.Spec.ProtectedTagPattern = "("
+
+	r := ReconcileQuayTagRetentionPolicy{ResourceReconciler: testutil.NewResourceReconciler(quayTagRetentionPolicy, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTagRetentionPolicy)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+
+	syncedCondition, found := quayTagRetentionPolicy.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceValidationFailure), syncedCondition.Reason)
+}
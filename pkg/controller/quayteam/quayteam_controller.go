@@ -0,0 +1,393 @@
+package quayteam
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Add creates a new QuayTeam Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayTeam {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quayteam-controller"))
+
+	return &ReconcileQuayTeam{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quayteam-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayTeam
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayTeam{}}, &handler.EnqueueRequestForObject{}, util.ResourceGenerationOrFinalizerChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayTeam{}, func() runtime.Object {
+		return &redhatcopv1alpha1.QuayTeamList{}
+	})
+}
+
+// blank assignment to verify that ReconcileQuayTeam implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayTeam{}
+
+// ReconcileQuayTeam reconciles a QuayTeam object
+type ReconcileQuayTeam struct {
+	quayapi.ResourceReconciler
+}
+
+// Reconcile synchronizes the team in Quay with the QuayTeam
+func (r *ReconcileQuayTeam) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayTeam")
+
+	quayTeam := &redhatcopv1alpha1.QuayTeam{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayTeam)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsBeingDeleted(quayTeam) {
+		return r.ManageDeletion(quayTeam, func(quayInstance *quayapi.QuayInstance) error {
+			return deleteTeam(quayInstance.QuayClient, getManagedTeamName(quayTeam))
+		})
+	}
+
+	updated, err := r.ManageFinalizer(quayTeam)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	// Teams cannot be renamed or moved
+	if quayTeam.Status.TeamName != "" && quayTeam.Status.TeamName != quayTeam.GetFullTeamName() {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Team %s cannot be renamed to %s", quayTeam.Status.TeamName, quayTeam.GetFullTeamName()))
+	}
+
+	// Users of synchronized teams are managed by the external authentication provider
+	if quayTeam.Spec.Sync != nil && len(quayTeam.Spec.Users) > 0 {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Users cannot be specified for team %s as it is synchronized with group %s", quayTeam.GetFullTeamName(), quayTeam.Spec.Sync.Group))
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayTeam)
+	if err != nil {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = syncTeam(quayInstance.QuayClient, quayTeam)
+	if err != nil {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	quayTeam.Status.TeamName = quayTeam.GetFullTeamName()
+
+	_, members, err := quayInstance.QuayClient.GetOrganizationTeamMembers(context.TODO(), quayTeam.Spec.Organization, quayTeam.GetTeamName())
+	if err != nil {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	if quayTeam.Spec.Sync != nil && getSyncService(members) == "" {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("Team %s cannot be synchronized as Quay does not use an external authentication provider supporting team synchronization", quayTeam.GetFullTeamName()))
+	}
+
+	err = syncTeamSync(quayInstance.QuayClient, quayTeam, members)
+	if err != nil {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = syncTeamMembers(quayInstance.QuayClient, quayTeam, members)
+	if err != nil {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	err = syncTeamPermissions(quayInstance.QuayClient, quayTeam)
+	if err != nil {
+		return r.ManageError(quayTeam, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	return r.ManageSuccess(quayTeam, "Team Synchronized Successfully")
+}
+
+// syncTeam creates the team or updates its role and description when they differ from the specification
+func syncTeam(quayClient *qclient.QuayClient, quayTeam *redhatcopv1alpha1.QuayTeam) error {
+
+	_, organization, err := quayClient.GetOrganization(context.TODO(), quayTeam.Spec.Organization)
+
+	if err != nil {
+		return err
+	}
+
+	team, found := organization.Teams[quayTeam.GetTeamName()]
+
+	if found && team.Role == string(quayTeam.GetRole()) && team.Description == quayTeam.Spec.Description {
+		return nil
+	}
+
+	if found {
+		logging.Log.Info("Updating Team", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName())
+	} else {
+		logging.Log.Info("Creating Team", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName())
+	}
+
+	// Teams are created and updated using the same request
+	_, _, err = quayClient.UpdateOrganizationTeam(context.TODO(), quayTeam.Spec.Organization, quayTeam.GetTeamName(), qclient.TeamUpdateRequest{
+		Role:        string(quayTeam.GetRole()),
+		Description: quayTeam.Spec.Description,
+	})
+
+	return err
+}
+
+// getSyncService returns the external authentication provider teams can be synchronized with
+func getSyncService(members qclient.TeamMembers) string {
+
+	if members.Synced != nil {
+		return members.Synced.Service
+	}
+
+	if members.CanSync != nil {
+		return members.CanSync.Service
+	}
+
+	return ""
+}
+
+// getSyncConfig returns the configuration identifying the group in the external authentication provider
+func getSyncConfig(service string, group string) map[string]string {
+
+	switch service {
+	case "ldap":
+		return map[string]string{"group_dn": group}
+	case "keystone":
+		return map[string]string{"group_id": group}
+	default:
+		return map[string]string{"group_name": group}
+	}
+}
+
+// syncTeamSync binds the team to the group of the external authentication provider or removes the binding
+func syncTeamSync(quayClient *qclient.QuayClient, quayTeam *redhatcopv1alpha1.QuayTeam, members qclient.TeamMembers) error {
+
+	organization := quayTeam.Spec.Organization
+	teamName := quayTeam.GetTeamName()
+
+	if quayTeam.Spec.Sync == nil {
+
+		quayTeam.Status.SyncService = ""
+
+		if members.Synced == nil {
+			return nil
+		}
+
+		logging.Log.Info("Disabling Team Synchronization", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName())
+
+		_, err := quayClient.DisableOrganizationTeamSync(context.TODO(), organization, teamName)
+
+		return err
+	}
+
+	service := getSyncService(members)
+	config := getSyncConfig(service, quayTeam.Spec.Sync.Group)
+
+	if members.Synced != nil {
+
+		if reflect.DeepEqual(members.Synced.Config, config) {
+			quayTeam.Status.SyncService = service
+			return nil
+		}
+
+		// The group of a synchronized team can only be changed by binding the team again
+		_, err := quayClient.DisableOrganizationTeamSync(context.TODO(), organization, teamName)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.Log.Info("Enabling Team Synchronization", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName(), "Group", quayTeam.Spec.Sync.Group)
+
+	_, _, err := quayClient.EnableOrganizationTeamSync(context.TODO(), organization, teamName, config)
+
+	if err != nil {
+		return err
+	}
+
+	quayTeam.Status.SyncService = service
+
+	return nil
+}
+
+// syncTeamMembers adds the declared members to the team and removes those that are not declared
+// Users of synchronized teams are left to the external authentication provider
+func syncTeamMembers(quayClient *qclient.QuayClient, quayTeam *redhatcopv1alpha1.QuayTeam, members qclient.TeamMembers) error {
+
+	organization := quayTeam.Spec.Organization
+	teamName := quayTeam.GetTeamName()
+	synced := quayTeam.Spec.Sync != nil
+
+	desired := map[string]bool{}
+
+	for _, user := range quayTeam.Spec.Users {
+		desired[user] = true
+	}
+
+	for _, robot := range quayTeam.Spec.Robots {
+		desired[quayTeam.GetRobotAccountName(robot)] = true
+	}
+
+	existing := map[string]bool{}
+
+	for _, member := range members.Members {
+
+		existing[member.Name] = true
+
+		if desired[member.Name] || (synced && !member.IsRobot) {
+			continue
+		}
+
+		logging.Log.Info("Removing Team Member", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName(), "Member", member.Name)
+
+		_, err := quayClient.RemoveOrganizationTeamMember(context.TODO(), organization, teamName, member.Name)
+
+		if err != nil && !qclient.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for member := range desired {
+
+		if existing[member] {
+			continue
+		}
+
+		logging.Log.Info("Adding Team Member", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName(), "Member", member)
+
+		_, _, err := quayClient.AddOrganizationTeamMember(context.TODO(), organization, teamName, member)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncTeamPermissions grants the repository permissions declared in the specification and revokes those that are not declared
+func syncTeamPermissions(quayClient *qclient.QuayClient, quayTeam *redhatcopv1alpha1.QuayTeam) error {
+
+	if quayTeam.Spec.RepositoryPermissions == nil {
+		return nil
+	}
+
+	organization := quayTeam.Spec.Organization
+	teamName := quayTeam.GetTeamName()
+
+	desired := map[string]redhatcopv1alpha1.QuayRepositoryRole{}
+
+	for _, permission := range quayTeam.Spec.RepositoryPermissions {
+		desired[permission.Repository] = permission.Role
+	}
+
+	_, existing, err := quayClient.GetOrganizationTeamPermissions(context.TODO(), organization, teamName)
+
+	if err != nil {
+		return err
+	}
+
+	existingRoles := map[string]string{}
+
+	for _, permission := range existing.Permissions {
+
+		existingRoles[permission.Repository.Name] = permission.Role
+
+		if _, found := desired[permission.Repository.Name]; found {
+			continue
+		}
+
+		logging.Log.Info("Revoking Team Permission", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName(), "Repository", permission.Repository.Name)
+
+		_, err := quayClient.DeleteRepositoryTeamPermission(context.TODO(), organization, permission.Repository.Name, teamName)
+
+		if err != nil && !qclient.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for repository, role := range desired {
+
+		if existingRoles[repository] == string(role) {
+			continue
+		}
+
+		logging.Log.Info("Granting Team Permission", "Namespace", quayTeam.Namespace, "Name", quayTeam.Name, "Team", quayTeam.GetFullTeamName(), "Repository", repository, "Role", role)
+
+		_, _, err := quayClient.SetRepositoryTeamPermission(context.TODO(), organization, repository, teamName, qclient.RepositoryPermissionRequest{
+			Role: string(role),
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteTeam deletes the team from Quay unless it no longer exists
+func deleteTeam(quayClient *qclient.QuayClient, fullTeamName string) error {
+
+	parts := strings.SplitN(fullTeamName, "/", 2)
+
+	if len(parts) != 2 {
+		return fmt.Errorf("Invalid team name %s", fullTeamName)
+	}
+
+	_, err := quayClient.DeleteOrganizationTeam(context.TODO(), parts[0], parts[1])
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// getManagedTeamName returns the full name of the team created in Quay
+func getManagedTeamName(quayTeam *redhatcopv1alpha1.QuayTeam) string {
+
+	if quayTeam.Status.TeamName != "" {
+		return quayTeam.Status.TeamName
+	}
+
+	return quayTeam.GetFullTeamName()
+}
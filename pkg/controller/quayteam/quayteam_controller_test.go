@@ -0,0 +1,263 @@
+package quayteam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var name = "developers"
+var namespace = "quay-enterprise"
+var organization = "example"
+
+// fakeQuay is the state of an organization held by the fake Quay server
+type fakeQuay struct {
+	teams   map[string]qclient.Team
+	members map[string]map[string]qclient.TeamMember
+	// permissions contains the role of each team by repository
+	permissions map[string]map[string]string
+	syncService string
+	synced      map[string]*qclient.TeamSyncStatus
+}
+
+func newFakeQuay(syncService string) *fakeQuay {
+	return &fakeQuay{
+		teams:       map[string]qclient.Team{},
+		members:     map[string]map[string]qclient.TeamMember{},
+		permissions: map[string]map[string]string{},
+		syncService: syncService,
+		synced:      map[string]*qclient.TeamSyncStatus{},
+	}
+}
+
+// newQuayServer returns a server implementing the team endpoints of the Quay API
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		if strings.HasPrefix(r.URL.Path, "/api/v1/repository/") {
+			// /api/v1/repository/{namespace}/{repository}/permissions/team/{teamname}
+			parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/repository/"), "/")
+			repository, teamName := parts[1], parts[4]
+
+			if quay.permissions[repository] == nil {
+				quay.permissions[repository] = map[string]string{}
+			}
+
+			switch r.Method {
+			case http.MethodPut:
+				request := qclient.RepositoryPermissionRequest{}
+				json.NewDecoder(r.Body).Decode(&request)
+				quay.permissions[repository][teamName] = request.Role
+				json.NewEncoder(w).Encode(qclient.RepositoryPermission{Name: teamName, Role: request.Role})
+			case http.MethodDelete:
+				delete(quay.permissions[repository], teamName)
+				w.WriteHeader(http.StatusNoContent)
+			}
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/organization/"), "/")
+
+		if len(parts) == 1 {
+			json.NewEncoder(w).Encode(qclient.Organization{Name: parts[0], Teams: quay.teams})
+			return
+		}
+
+		teamName := parts[2]
+
+		switch {
+		case len(parts) == 3 && r.Method == http.MethodPut:
+			request := qclient.TeamUpdateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			quay.teams[teamName] = qclient.Team{Name: teamName, Role: request.Role, Description: request.Description}
+			if quay.members[teamName] == nil {
+				quay.members[teamName] = map[string]qclient.TeamMember{}
+			}
+			json.NewEncoder(w).Encode(quay.teams[teamName])
+		case parts[3] == "members" && r.Method == http.MethodGet:
+			members := qclient.TeamMembers{Name: teamName, Members: []qclient.TeamMember{}, Synced: quay.synced[teamName]}
+			if quay.syncService != "" && quay.synced[teamName] == nil {
+				members.CanSync = &qclient.TeamSyncService{Service: quay.syncService}
+			}
+			for _, member := range quay.members[teamName] {
+				members.Members = append(members.Members, member)
+			}
+			json.NewEncoder(w).Encode(members)
+		case parts[3] == "members" && r.Method == http.MethodPut:
+			quay.members[teamName][parts[4]] = qclient.TeamMember{Name: parts[4], IsRobot: strings.Contains(parts[4], "+")}
+			json.NewEncoder(w).Encode(quay.members[teamName][parts[4]])
+		case parts[3] == "members" && r.Method == http.MethodDelete:
+			delete(quay.members[teamName], parts[4])
+			w.WriteHeader(http.StatusNoContent)
+		case parts[3] == "permissions":
+			permissions := qclient.TeamPermissions{Permissions: []qclient.TeamPermission{}}
+			for repository, roles := range quay.permissions {
+				if role, found := roles[teamName]; found {
+					permissions.Permissions = append(permissions.Permissions, qclient.TeamPermission{
+						Repository: qclient.TeamPermissionRepository{Name: repository},
+						Role:       role,
+					})
+				}
+			}
+			json.NewEncoder(w).Encode(permissions)
+		case parts[3] == "syncing" && r.Method == http.MethodPost:
+			config := map[string]string{}
+			json.NewDecoder(r.Body).Decode(&config)
+			quay.synced[teamName] = &qclient.TeamSyncStatus{Service: quay.syncService, Config: config}
+			w.Write([]byte(`{"success": true}`))
+		case parts[3] == "syncing" && r.Method == http.MethodDelete:
+			delete(quay.synced, teamName)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func TestReconcileTeamMembership(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := newFakeQuay("")
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayTeam := &redhatcopv1alpha1.QuayTeam{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayTeamSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Organization: organization,
+			Role:         redhatcopv1alpha1.CreatorQuayTeamRole,
+			Users:        []string{"alice", "bob"},
+			Robots:       []string{"builder"},
+			RepositoryPermissions: []redhatcopv1alpha1.QuayTeamPermission{
+				{Repository: "app", Role: redhatcopv1alpha1.WriteQuayRepositoryRole},
+			},
+		},
+	}
+
+	r := ReconcileQuayTeam{ResourceReconciler: testutil.NewResourceReconciler(quayTeam, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTeam)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Equal(t, "creator", quay.teams[name].Role)
+	assert.Len(t, quay.members[name], 3)
+	assert.Contains(t, quay.members[name], organization+"+builder")
+	assert.Equal(t, "write", quay.permissions["app"][name])
+	assert.Equal(t, organization+"/"+name, quayTeam.Status.TeamName)
+
+	// Drift in the membership and role is repaired
+	quay.teams[name] = qclient.Team{Name: name, Role: "admin"}
+	delete(quay.members[name], "alice")
+	quay.members[name]["mallory"] = qclient.TeamMember{Name: "mallory"}
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTeam)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "creator", quay.teams[name].Role)
+	assert.Contains(t, quay.members[name], "alice")
+	assert.NotContains(t, quay.members[name], "mallory")
+}
+
+func TestReconcileSyncedTeam(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := newFakeQuay("ldap")
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	group := "cn=developers,ou=groups,dc=example,dc=com"
+
+	quayTeam := &redhatcopv1alpha1.QuayTeam{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayTeamSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Organization: organization,
+			Robots:       []string{"builder"},
+			Sync:         &redhatcopv1alpha1.QuayTeamSync{Group: group},
+		},
+	}
+
+	r := ReconcileQuayTeam{ResourceReconciler: testutil.NewResourceReconciler(quayTeam, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	_, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTeam)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"group_dn": group}, quay.synced[name].Config)
+	assert.Equal(t, "ldap", quayTeam.Status.SyncService)
+
+	// Users added by the synchronization are retained while undeclared robot accounts are removed
+	quay.members[name]["alice"] = qclient.TeamMember{Name: "alice"}
+	quay.members[name][organization+"+other"] = qclient.TeamMember{Name: organization + "+other", IsRobot: true}
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTeam)
+
+	assert.NoError(t, err)
+	assert.Contains(t, quay.members[name], "alice")
+	assert.Contains(t, quay.members[name], organization+"+builder")
+	assert.NotContains(t, quay.members[name], organization+"+other")
+
+	// Removing the binding disables the synchronization
+	quayTeam.Spec.Sync = nil
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), quayTeam))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTeam)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, quay.synced, name)
+	assert.Empty(t, quayTeam.Status.SyncService)
+}
+
+func TestReconcileSyncNotSupported(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := newFakeQuay("")
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayTeam := &redhatcopv1alpha1.QuayTeam{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayTeamSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Organization: organization,
+			Sync:         &redhatcopv1alpha1.QuayTeamSync{Group: "developers"},
+		},
+	}
+
+	r := ReconcileQuayTeam{ResourceReconciler: testutil.NewResourceReconciler(quayTeam, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayTeam)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+
+	syncedCondition, found := quayTeam.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition.Status)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceValidationFailure), syncedCondition.Reason)
+}
@@ -0,0 +1,263 @@
+package quayuser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// minimumPasswordLength is the minimum length of the passwords accepted by Quay
+	minimumPasswordLength = 8
+)
+
+// Add creates a new QuayUser Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) *ReconcileQuayUser {
+
+	reconcilerBase := util.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor("quayuser-controller"))
+
+	return &ReconcileQuayUser{ResourceReconciler: quayapi.ResourceReconciler{ReconcilerBase: reconcilerBase}}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("quayuser-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource QuayUser
+	err = c.Watch(&source.Kind{Type: &redhatcopv1alpha1.QuayUser{}}, &handler.EnqueueRequestForObject{}, util.ResourceGenerationOrFinalizerChangedPredicate{})
+	if err != nil {
+		return err
+	}
+
+	newList := func() runtime.Object {
+		return &redhatcopv1alpha1.QuayUserList{}
+	}
+
+	// Watch for the Secrets containing the initial passwords becoming available
+	err = quayapi.WatchReferencedSecrets(mgr, c, &redhatcopv1alpha1.QuayUser{}, newList, func(obj runtime.Object) []string {
+
+		quayUser, ok := obj.(*redhatcopv1alpha1.QuayUser)
+
+		if !ok || quayUser.Spec.PasswordSecretName == "" {
+			return nil
+		}
+
+		return []string{quayUser.Spec.PasswordSecretName}
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the referenced QuayEcosystem becoming available
+	return quayapi.WatchQuayEcosystems(mgr, c, &redhatcopv1alpha1.QuayUser{}, newList)
+}
+
+// blank assignment to verify that ReconcileQuayUser implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileQuayUser{}
+
+// ReconcileQuayUser reconciles a QuayUser object
+type ReconcileQuayUser struct {
+	quayapi.ResourceReconciler
+}
+
+// Reconcile synchronizes the user in Quay with the QuayUser through the superuser API
+func (r *ReconcileQuayUser) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := logging.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling QuayUser")
+
+	quayUser := &redhatcopv1alpha1.QuayUser{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), request.NamespacedName, quayUser)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsBeingDeleted(quayUser) {
+		return r.ManageDeletion(quayUser, func(quayInstance *quayapi.QuayInstance) error {
+			return deleteUser(quayInstance.QuayClient, getManagedUsername(quayUser))
+		})
+	}
+
+	updated, err := r.ManageFinalizer(quayUser)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if updated {
+		return reconcile.Result{}, nil
+	}
+
+	// Users cannot be renamed
+	if quayUser.Status.Username != "" && quayUser.Status.Username != quayUser.Spec.Username {
+		return r.ManageError(quayUser, redhatcopv1alpha1.QuayResourceValidationFailure, fmt.Errorf("User %s cannot be renamed to %s", quayUser.Status.Username, quayUser.Spec.Username))
+	}
+
+	password, err := r.getInitialPassword(quayUser)
+	if err != nil {
+		return r.ManageError(quayUser, redhatcopv1alpha1.QuayResourceValidationFailure, err)
+	}
+
+	quayInstance, err := r.GetQuayInstance(quayUser)
+	if err != nil {
+		return r.ManageError(quayUser, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	user, err := syncUser(quayInstance.QuayClient, quayUser, password)
+	if err != nil {
+		return r.ManageError(quayUser, redhatcopv1alpha1.QuayResourceSyncFailure, err)
+	}
+
+	quayUser.Status.Username = user.Username
+	quayUser.Status.Enabled = user.Enabled
+
+	return r.ManageSuccess(quayUser, "User Synchronized Successfully")
+}
+
+// getInitialPassword returns the initial password contained in the referenced Secret or an empty string when no Secret is referenced
+func (r *ReconcileQuayUser) getInitialPassword(quayUser *redhatcopv1alpha1.QuayUser) (string, error) {
+
+	if quayUser.Spec.PasswordSecretName == "" {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.ReconcilerBase.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: quayUser.Namespace, Name: quayUser.Spec.PasswordSecretName}, secret)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", fmt.Errorf("Password secret %s not found", quayUser.Spec.PasswordSecretName)
+		}
+		return "", err
+	}
+
+	password := string(secret.Data[constants.QuayUserPasswordSecretKey])
+
+	if len(password) < minimumPasswordLength {
+		return "", fmt.Errorf("Password in secret %s must be at least %d characters in length", quayUser.Spec.PasswordSecretName, minimumPasswordLength)
+	}
+
+	return password, nil
+}
+
+// syncUser creates the user or updates its email and whether it is enabled and returns the user reported by Quay
+// The initial password is only set on users created by the operator so that existing users keep their password
+func syncUser(quayClient *qclient.QuayClient, quayUser *redhatcopv1alpha1.QuayUser, password string) (qclient.User, error) {
+
+	username := quayUser.Spec.Username
+	created := false
+
+	_, user, err := quayClient.GetSuperuserUser(context.TODO(), username)
+
+	if qclient.IsNotFound(err) {
+		logging.Log.Info("Creating User", "Namespace", quayUser.Namespace, "Name", quayUser.Name, "Username", username)
+
+		_, user, err = quayClient.CreateSuperuserUser(context.TODO(), qclient.UserCreateRequest{
+			Username: username,
+			Email:    quayUser.Spec.Email,
+		})
+
+		if err != nil {
+			return qclient.User{}, err
+		}
+
+		// New users are enabled
+		user.Enabled = true
+		created = true
+
+	} else if err != nil {
+		return qclient.User{}, err
+	}
+
+	desired := qclient.UserUpdateRequest{}
+	changed := false
+
+	if created && password != "" {
+		desired.Password = password
+		changed = true
+	}
+
+	if user.Email != quayUser.Spec.Email {
+		desired.Email = quayUser.Spec.Email
+		changed = true
+	}
+
+	enabled := !quayUser.Spec.Disabled
+
+	if user.Enabled != enabled {
+		desired.Enabled = &enabled
+		changed = true
+	}
+
+	if !changed {
+		return user, nil
+	}
+
+	logging.Log.Info("Updating User", "Namespace", quayUser.Namespace, "Name", quayUser.Name, "Username", username)
+
+	_, _, err = quayClient.UpdateSuperuserUser(context.TODO(), username, desired)
+
+	if err != nil {
+
+		// Users whose initial password could not be set are removed so that their creation is retried rather than adopting them
+		if created {
+			if err := deleteUser(quayClient, username); err != nil {
+				logging.Log.Error(err, "Failed to delete user after setting its initial password failed", "Namespace", quayUser.Namespace, "Name", quayUser.Name, "Username", username)
+			}
+		}
+
+		return qclient.User{}, err
+	}
+
+	user.Email = quayUser.Spec.Email
+	user.Enabled = enabled
+
+	return user, nil
+}
+
+// deleteUser deletes the user from Quay unless it no longer exists
+func deleteUser(quayClient *qclient.QuayClient, username string) error {
+
+	_, err := quayClient.DeleteSuperuserUser(context.TODO(), username)
+
+	if qclient.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// getManagedUsername returns the name of the user managed in Quay
+func getManagedUsername(quayUser *redhatcopv1alpha1.QuayUser) string {
+
+	if quayUser.Status.Username != "" {
+		return quayUser.Status.Username
+	}
+
+	return quayUser.Spec.Username
+}
@@ -0,0 +1,208 @@
+package quayuser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	qclient "github.com/redhat-cop/quay-operator/pkg/client"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	testutil "github.com/redhat-cop/quay-operator/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var name = "example-user"
+var namespace = "quay-enterprise"
+var passwordSecretName = "example-user-password"
+
+// fakeQuay is the state held by the fake Quay server
+type fakeQuay struct {
+	users     map[string]qclient.User
+	passwords map[string]string
+	updates   int
+}
+
+// newQuayServer returns a server implementing the user management endpoints of the superuser API of Quay
+func newQuayServer(quay *fakeQuay) *httptest.Server {
+	return testutil.NewQuayServer(func(w http.ResponseWriter, r *http.Request) {
+
+		username := strings.TrimPrefix(r.URL.Path, "/api/v1/superuser/users/")
+		user, found := quay.users[username]
+
+		switch {
+		case username == "" && r.Method == http.MethodPost:
+			request := qclient.UserCreateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			quay.users[request.Username] = qclient.User{Username: request.Username, Email: request.Email, Enabled: true}
+			quay.passwords[request.Username] = "generated"
+			json.NewEncoder(w).Encode(map[string]string{"username": request.Username, "email": request.Email, "password": "generated"})
+		case !found:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(user)
+		case r.Method == http.MethodPut:
+			request := qclient.UserUpdateRequest{}
+			json.NewDecoder(r.Body).Decode(&request)
+			if request.Email != "" {
+				user.Email = request.Email
+			}
+			if request.Password != "" {
+				quay.passwords[username] = request.Password
+			}
+			if request.Enabled != nil {
+				user.Enabled = *request.Enabled
+			}
+			quay.users[username] = user
+			quay.updates++
+			json.NewEncoder(w).Encode(user)
+		case r.Method == http.MethodDelete:
+			delete(quay.users, username)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func newQuayUser() *redhatcopv1alpha1.QuayUser {
+	return &redhatcopv1alpha1.QuayUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: redhatcopv1alpha1.QuayUserSpec{
+			QuayResourceSpec: redhatcopv1alpha1.QuayResourceSpec{
+				QuayEcosystemName: testutil.QuayEcosystemName,
+			},
+			Username:           "builder",
+			Email:              "builder@example.com",
+			PasswordSecretName: passwordSecretName,
+		},
+	}
+}
+
+func newPasswordSecret(password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      passwordSecretName,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			constants.QuayUserPasswordSecretKey: []byte(password),
+		},
+	}
+}
+
+func TestReconcileUser(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{users: map[string]qclient.User{}, passwords: map[string]string{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayUser := newQuayUser()
+
+	r := ReconcileQuayUser{ResourceReconciler: testutil.NewResourceReconciler(quayUser, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace), newPasswordSecret("initial-password"))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayUser)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Equal(t, "builder@example.com", quay.users["builder"].Email)
+	assert.Equal(t, "initial-password", quay.passwords["builder"])
+	assert.Equal(t, "builder", quayUser.Status.Username)
+	assert.True(t, quayUser.Status.Enabled)
+
+	// The initial password is not applied again
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), newPasswordSecret("changed-password")))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayUser)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, quay.updates)
+	assert.Equal(t, "initial-password", quay.passwords["builder"])
+
+	// Disabled users and changed emails are updated
+	quayUser.Spec.Disabled = true
+	quayUser.Spec.Email = "ci@example.com"
+	assert.NoError(t, r.ReconcilerBase.GetClient().Update(context.TODO(), quayUser))
+
+	_, err = testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayUser)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, quay.updates)
+	assert.False(t, quay.users["builder"].Enabled)
+	assert.Equal(t, "ci@example.com", quay.users["builder"].Email)
+	assert.False(t, quayUser.Status.Enabled)
+}
+
+func TestReconcileExistingUser(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{users: map[string]qclient.User{"builder": {Username: "builder", Email: "before@example.com", Enabled: true}}, passwords: map[string]string{"builder": "existing-password"}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayUser := newQuayUser()
+
+	r := ReconcileQuayUser{ResourceReconciler: testutil.NewResourceReconciler(quayUser, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace), newPasswordSecret("initial-password"))}
+
+	// Users which already exist keep their password
+	_, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayUser)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "builder@example.com", quay.users["builder"].Email)
+	assert.Equal(t, "existing-password", quay.passwords["builder"])
+	assert.Equal(t, "builder", quayUser.Status.Username)
+}
+
+func TestReconcileUserValidation(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{users: map[string]qclient.User{}, passwords: map[string]string{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	quayUser := newQuayUser()
+
+	r := ReconcileQuayUser{ResourceReconciler: testutil.NewResourceReconciler(quayUser, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace), newPasswordSecret("short"))}
+
+	result, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayUser)
+
+	assert.NoError(t, err)
+	assert.Equal(t, quayapi.ResyncPeriod, result.RequeueAfter)
+	assert.Empty(t, quay.users)
+
+	syncedCondition, found := quayUser.Status.FindConditionByType(redhatcopv1alpha1.QuayResourceSyncedCondition)
+	assert.True(t, found)
+	assert.Equal(t, string(redhatcopv1alpha1.QuayResourceValidationFailure), syncedCondition.Reason)
+}
+
+func TestReconcileUserDeletion(t *testing.T) {
+	testutil.SetupLogging()
+
+	quay := &fakeQuay{users: map[string]qclient.User{"builder": {Username: "builder", Enabled: true}}, passwords: map[string]string{}}
+	server := newQuayServer(quay)
+	defer server.Close()
+
+	deletionTimestamp := metav1.Now()
+
+	quayUser := newQuayUser()
+	quayUser.Spec.DeletionPolicy = redhatcopv1alpha1.DeleteQuayResourceDeletionPolicy
+	quayUser.Finalizers = []string{quayapi.QuayResourceFinalizer}
+	quayUser.DeletionTimestamp = &deletionTimestamp
+	quayUser.Status.Username = "builder"
+
+	r := ReconcileQuayUser{ResourceReconciler: testutil.NewResourceReconciler(quayUser, testutil.NewReadyQuayEcosystem(t, namespace, server), testutil.NewQuayAPITokenSecret(namespace))}
+
+	_, err := testutil.ReconcileResource(&r, r.ReconcilerBase.GetClient(), quayUser)
+
+	assert.NoError(t, err)
+	assert.Empty(t, quay.users)
+	assert.NotContains(t, quayUser.Finalizers, quayapi.QuayResourceFinalizer)
+}
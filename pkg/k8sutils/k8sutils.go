@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/logging"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -29,6 +32,59 @@ func GetK8sClient(config *rest.Config) (kubernetes.Interface, error) {
 
 }
 
+// ParseWatchNamespaces splits a comma separated list of namespaces to watch
+// An empty list is returned when all namespaces should be watched
+func ParseWatchNamespaces(watchNamespace string) []string {
+
+	namespaces := []string{}
+
+	for _, namespace := range strings.Split(watchNamespace, ",") {
+		namespace = strings.TrimSpace(namespace)
+
+		if namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	return namespaces
+}
+
+// IsOpenShift queries for a known OpenShift API resource to determine whether the operator is running in OpenShift
+func IsOpenShift(discoveryClient discovery.DiscoveryInterface) bool {
+
+	_, resourcesErr := discoveryClient.ServerResourcesForGroupVersion("security.openshift.io/v1")
+
+	if resourcesErr != nil {
+		if errors.IsNotFound(resourcesErr) {
+			return false
+		}
+		logging.Log.Error(resourcesErr, "Error Determining Whether Quay Operator Running in OpenShift")
+	}
+
+	return true
+}
+
+// HasAPIResource determines whether the API server serves the resource in the provided group version
+func HasAPIResource(discoveryClient discovery.DiscoveryInterface, groupVersion string, resource string) bool {
+
+	resources, resourcesErr := discoveryClient.ServerResourcesForGroupVersion(groupVersion)
+
+	if resourcesErr != nil {
+		if !errors.IsNotFound(resourcesErr) {
+			logging.Log.Error(resourcesErr, "Error Determining Whether API Resource is Available", "GroupVersion", groupVersion, "Resource", resource)
+		}
+		return false
+	}
+
+	for _, apiResource := range resources.APIResources {
+		if apiResource.Name == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
 func GetDeploymentStatus(k8sclient kubernetes.Interface, namespace string, name string) bool {
 
 	if k8sclient == nil {
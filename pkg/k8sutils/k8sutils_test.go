@@ -0,0 +1,32 @@
+package k8sutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWatchNamespaces(t *testing.T) {
+
+	cases := []struct {
+		watchNamespace string
+		expected       []string
+	}{
+		{
+			watchNamespace: "",
+			expected:       []string{},
+		},
+		{
+			watchNamespace: "quay-enterprise",
+			expected:       []string{"quay-enterprise"},
+		},
+		{
+			watchNamespace: "tenant-a, tenant-b,,tenant-c",
+			expected:       []string{"tenant-a", "tenant-b", "tenant-c"},
+		},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, ParseWatchNamespaces(c.watchNamespace))
+	}
+}
@@ -0,0 +1,90 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBodyLength is the maximum length of an unstructured response body reported in an APIError
+const maxErrorBodyLength = 512
+
+// APIError is returned when the registry responds to a request with an unsuccessful status
+type APIError struct {
+	// StatusCode is the HTTP status of the response
+	StatusCode int `json:"-"`
+	// Method and Path identify the failed request
+	Method string `json:"-"`
+	Path   string `json:"-"`
+	// Errors are reported by the registry in the body of the response when available
+	Errors []ErrorDetail `json:"errors"`
+	// Message is the unstructured body of the response
+	Message string `json:"-"`
+}
+
+// ErrorDetail is an error reported by the registry
+type ErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+
+	message := fmt.Sprintf("Registry request %s %s failed with status %d", e.Method, e.Path, e.StatusCode)
+
+	details := []string{}
+	for _, detail := range e.Errors {
+		details = append(details, fmt.Sprintf("%s: %s", detail.Code, detail.Message))
+	}
+
+	switch {
+	case len(details) > 0:
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(details, ", "))
+	case e.Message != "":
+		message = fmt.Sprintf("%s: %s", message, e.Message)
+	}
+
+	return message
+}
+
+// newAPIError builds the error describing the unsuccessful response from the errors reported by the registry in its body
+func newAPIError(resp *http.Response, body []byte) *APIError {
+
+	apiError := &APIError{StatusCode: resp.StatusCode}
+
+	if resp.Request != nil {
+		apiError.Method = resp.Request.Method
+		apiError.Path = resp.Request.URL.Path
+	}
+
+	if len(body) == 0 {
+		return apiError
+	}
+
+	if json.Unmarshal(body, apiError) != nil || len(apiError.Errors) == 0 {
+		message := strings.TrimSpace(string(body))
+		if len(message) > maxErrorBodyLength {
+			message = message[:maxErrorBodyLength]
+		}
+		apiError.Message = message
+	}
+
+	return apiError
+}
+
+// IsStatus determines whether the registry responded with the provided status
+func IsStatus(err error, statusCode int) bool {
+	apiError, ok := err.(*APIError)
+	return ok && apiError.StatusCode == statusCode
+}
+
+// IsNotFound determines whether the registry responded that the requested content does not exist
+func IsNotFound(err error) bool {
+	return IsStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized determines whether the registry rejected the credentials of the client
+func IsUnauthorized(err error) bool {
+	return IsStatus(err, http.StatusUnauthorized)
+}
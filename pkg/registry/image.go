@@ -0,0 +1,112 @@
+package registry
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Image is an image whose configuration and layers are held in memory
+type Image struct {
+	// Config is the encoded configuration of the image
+	Config []byte
+	// Layers are the gzip compressed layers of the image
+	Layers [][]byte
+}
+
+// NewScratchImage returns an image made of a single layer containing the provided files
+func NewScratchImage(files map[string][]byte) (*Image, error) {
+
+	layer := new(bytes.Buffer)
+	writer := tar.NewWriter(layer)
+
+	// Files are written in a stable order so that the same files always produce the same layer
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err := writer.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(files[name])),
+			Typeflag: tar.TypeReg,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := writer.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	compressedLayer := new(bytes.Buffer)
+	compressor := gzip.NewWriter(compressedLayer)
+
+	if _, err := compressor.Write(layer.Bytes()); err != nil {
+		return nil, err
+	}
+
+	if err := compressor.Close(); err != nil {
+		return nil, err
+	}
+
+	config, err := json.Marshal(ImageConfig{
+		Architecture: "amd64",
+		OS:           "linux",
+		Created:      time.Now().UTC().Format(time.RFC3339),
+		RootFS: ImageRootFS{
+			Type:    "layers",
+			DiffIDs: []string{Digest(layer.Bytes())},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Image{
+		Config: config,
+		Layers: [][]byte{compressedLayer.Bytes()},
+	}, nil
+}
+
+// Manifest returns the manifest referencing the configuration and layers of the image
+func (i *Image) Manifest() Manifest {
+
+	manifest := Manifest{
+		SchemaVersion: 2,
+		MediaType:     ManifestMediaType,
+		Config:        newDescriptor(ImageConfigMediaType, i.Config),
+		Layers:        []Descriptor{},
+	}
+
+	for _, layer := range i.Layers {
+		manifest.Layers = append(manifest.Layers, newDescriptor(LayerMediaType, layer))
+	}
+
+	return manifest
+}
+
+// Digest returns the SHA-256 digest of the content in the format used by the registry
+func Digest(content []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+}
+
+func newDescriptor(mediaType string, content []byte) Descriptor {
+	return Descriptor{
+		MediaType: mediaType,
+		Size:      int64(len(content)),
+		Digest:    Digest(content),
+	}
+}
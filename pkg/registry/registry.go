@@ -0,0 +1,523 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRequestTimeout bounds each request whose context has no deadline
+	DefaultRequestTimeout = 30 * time.Second
+)
+
+var (
+	// challengeParameterPattern matches the parameters of a WWW-Authenticate challenge
+	challengeParameterPattern = regexp.MustCompile(`([a-zA-Z_]+)="([^"]*)"`)
+	// nextPagePattern matches the Link header referencing the next page of a listing
+	nextPagePattern = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+)
+
+// RegistryClient is a client for the Docker Registry HTTP API V2 served by Quay
+// Requests are authorized with the credentials requested by the challenges of the registry, which are bearer tokens issued for a scope in the case of Quay
+type RegistryClient struct {
+	BaseURL    *url.URL
+	httpClient *http.Client
+	Username   string
+	Password   string
+	// RequestTimeout bounds each request whose context has no deadline
+	RequestTimeout time.Duration
+
+	mutex sync.Mutex
+	// authorizations are the Authorization headers obtained for each scope
+	authorizations map[string]string
+}
+
+// GetCatalog returns the repositories visible to the user of the client
+func (c *RegistryClient) GetCatalog(ctx context.Context) ([]string, error) {
+
+	repositories := []string{}
+
+	err := c.getPages(ctx, c.url("/v2/_catalog"), "", func(body []byte) error {
+		catalog := Catalog{}
+		if err := json.Unmarshal(body, &catalog); err != nil {
+			return err
+		}
+		repositories = append(repositories, catalog.Repositories...)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return repositories, nil
+}
+
+// GetTags returns the tags of the repository
+func (c *RegistryClient) GetTags(ctx context.Context, repository string) ([]string, error) {
+
+	tags := []string{}
+
+	err := c.getPages(ctx, c.url(fmt.Sprintf("/v2/%s/tags/list", repository)), repositoryScope(repository, "pull"), func(body []byte) error {
+		tagList := TagList{}
+		if err := json.Unmarshal(body, &tagList); err != nil {
+			return err
+		}
+		tags = append(tags, tagList.Tags...)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// PushBlob uploads the content to the repository in a single request and returns its digest
+func (c *RegistryClient) PushBlob(ctx context.Context, repository string, content []byte) (string, error) {
+
+	digest := Digest(content)
+	scope := repositoryScope(repository, "pull", "push")
+
+	req, err := c.newRequest(ctx, http.MethodPost, c.url(fmt.Sprintf("/v2/%s/blobs/uploads/", repository)), nil, "")
+	if err != nil {
+		return "", err
+	}
+
+	resp, _, err := c.do(req, scope)
+	if err != nil {
+		return "", err
+	}
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("Registry did not return the location of the upload of blob %s", digest)
+	}
+
+	// The location of the upload may be relative to the request starting it
+	uploadURL, err := resp.Request.URL.Parse(location)
+	if err != nil {
+		return "", err
+	}
+
+	query := uploadURL.Query()
+	query.Set("digest", digest)
+	uploadURL.RawQuery = query.Encode()
+
+	req, err = c.newRequest(ctx, http.MethodPut, uploadURL, content, "application/octet-stream")
+	if err != nil {
+		return "", err
+	}
+
+	if _, _, err = c.do(req, scope); err != nil {
+		return "", err
+	}
+
+	return digest, nil
+}
+
+// GetBlob downloads the content identified by the digest from the repository and verifies it against the digest
+func (c *RegistryClient) GetBlob(ctx context.Context, repository string, digest string) ([]byte, error) {
+
+	req, err := c.newRequest(ctx, http.MethodGet, c.url(fmt.Sprintf("/v2/%s/blobs/%s", repository, digest)), nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	_, body, err := c.do(req, repositoryScope(repository, "pull"))
+	if err != nil {
+		return nil, err
+	}
+
+	if Digest(body) != digest {
+		return nil, fmt.Errorf("Content of blob %s of repository %s does not match its digest", digest, repository)
+	}
+
+	return body, nil
+}
+
+// PutManifest uploads the manifest to the repository under the reference, usually a tag, and returns its digest
+func (c *RegistryClient) PutManifest(ctx context.Context, repository string, reference string, manifest Manifest) (string, error) {
+
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, c.url(fmt.Sprintf("/v2/%s/manifests/%s", repository, reference)), content, manifest.MediaType)
+	if err != nil {
+		return "", err
+	}
+
+	resp, _, err := c.do(req, repositoryScope(repository, "pull", "push"))
+	if err != nil {
+		return "", err
+	}
+
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+
+	return Digest(content), nil
+}
+
+// GetManifest returns the manifest identified by the reference, either a tag or a digest, along with its digest
+func (c *RegistryClient) GetManifest(ctx context.Context, repository string, reference string) (Manifest, string, error) {
+
+	req, err := c.newRequest(ctx, http.MethodGet, c.url(fmt.Sprintf("/v2/%s/manifests/%s", repository, reference)), nil, "")
+	if err != nil {
+		return Manifest{}, "", err
+	}
+	req.Header.Set("Accept", ManifestMediaType)
+
+	_, body, err := c.do(req, repositoryScope(repository, "pull"))
+	if err != nil {
+		return Manifest{}, "", err
+	}
+
+	digest := Digest(body)
+	if strings.HasPrefix(reference, "sha256:") && reference != digest {
+		return Manifest{}, "", fmt.Errorf("Content of manifest %s of repository %s does not match its digest", reference, repository)
+	}
+
+	manifest := Manifest{}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return Manifest{}, "", err
+	}
+
+	return manifest, digest, nil
+}
+
+// DeleteManifest deletes the manifest identified by the digest along with the tags referencing it
+// Quay authorizes deletions with the push permission
+func (c *RegistryClient) DeleteManifest(ctx context.Context, repository string, digest string) error {
+
+	req, err := c.newRequest(ctx, http.MethodDelete, c.url(fmt.Sprintf("/v2/%s/manifests/%s", repository, digest)), nil, "")
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.do(req, repositoryScope(repository, "pull", "push"))
+
+	return err
+}
+
+// PushImage uploads the configuration and layers of the image followed by its manifest tagged with the provided tag and returns the digest of the manifest
+func (c *RegistryClient) PushImage(ctx context.Context, repository string, tag string, image *Image) (string, error) {
+
+	for _, blob := range append([][]byte{image.Config}, image.Layers...) {
+		if _, err := c.PushBlob(ctx, repository, blob); err != nil {
+			return "", err
+		}
+	}
+
+	return c.PutManifest(ctx, repository, tag, image.Manifest())
+}
+
+// PullImage downloads the image identified by the reference and returns it along with the digest of its manifest
+func (c *RegistryClient) PullImage(ctx context.Context, repository string, reference string) (*Image, string, error) {
+
+	manifest, digest, err := c.GetManifest(ctx, repository, reference)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if manifest.MediaType != ManifestMediaType {
+		return nil, "", fmt.Errorf("Unsupported media type %s of manifest %s of repository %s", manifest.MediaType, reference, repository)
+	}
+
+	image := &Image{}
+
+	image.Config, err = c.GetBlob(ctx, repository, manifest.Config.Digest)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, layer := range manifest.Layers {
+		content, err := c.GetBlob(ctx, repository, layer.Digest)
+		if err != nil {
+			return nil, "", err
+		}
+		image.Layers = append(image.Layers, content)
+	}
+
+	return image, digest, nil
+}
+
+// getPages requests the listing and each following page advertised in the Link header of the responses
+func (c *RegistryClient) getPages(ctx context.Context, pageURL *url.URL, scope string, page func(body []byte) error) error {
+
+	for pageURL != nil {
+
+		req, err := c.newRequest(ctx, http.MethodGet, pageURL, nil, "")
+		if err != nil {
+			return err
+		}
+
+		resp, body, err := c.do(req, scope)
+		if err != nil {
+			return err
+		}
+
+		if err := page(body); err != nil {
+			return err
+		}
+
+		pageURL = nextPage(resp)
+	}
+
+	return nil
+}
+
+// nextPage returns the location of the next page of a listing or nil for the last page
+func nextPage(resp *http.Response) *url.URL {
+
+	match := nextPagePattern.FindStringSubmatch(resp.Header.Get("Link"))
+	if match == nil {
+		return nil
+	}
+
+	pageURL, err := resp.Request.URL.Parse(match[1])
+	if err != nil {
+		return nil
+	}
+
+	return pageURL
+}
+
+// repositoryScope returns the scope of a token granting the actions on the repository
+func repositoryScope(repository string, actions ...string) string {
+	return fmt.Sprintf("repository:%s:%s", repository, strings.Join(actions, ","))
+}
+
+func (c *RegistryClient) url(path string) *url.URL {
+	return c.BaseURL.ResolveReference(&url.URL{Path: path})
+}
+
+func (c *RegistryClient) newRequest(ctx context.Context, method string, u *url.URL, body []byte, contentType string) (*http.Request, error) {
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return req, nil
+}
+
+// do sends the request authorized for the scope and returns the response along with its body
+// Requests challenged by the registry are sent again once the credentials requested by the challenge have been obtained
+func (c *RegistryClient) do(req *http.Request, scope string) (*http.Response, []byte, error) {
+
+	c.authorize(req, scope)
+
+	resp, body, err := c.send(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && resp.Header.Get("WWW-Authenticate") != "" {
+
+		if err := c.authenticate(req.Context(), resp, scope); err != nil {
+			return resp, body, err
+		}
+
+		// The body was consumed by the challenged request
+		if req.GetBody != nil {
+			requestBody, err := req.GetBody()
+			if err != nil {
+				return resp, body, err
+			}
+			req.Body = requestBody
+		}
+
+		c.authorize(req, scope)
+
+		resp, body, err = c.send(req)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !isSuccessful(resp) {
+		return resp, body, newAPIError(resp, body)
+	}
+
+	return resp, body, nil
+}
+
+// send performs the request and reads the body of the response
+func (c *RegistryClient) send(req *http.Request) (*http.Response, []byte, error) {
+
+	ctx, cancel := c.requestContext(req.Context())
+	defer cancel()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, body, nil
+}
+
+// authorize sets the Authorization header previously obtained for the scope on the request
+func (c *RegistryClient) authorize(req *http.Request, scope string) {
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if authorization, ok := c.authorizations[scope]; ok {
+		req.Header.Set("Authorization", authorization)
+	}
+}
+
+// authenticate obtains the credentials requested by the challenge of the response for the scope
+func (c *RegistryClient) authenticate(ctx context.Context, resp *http.Response, scope string) error {
+
+	scheme, parameters := parseChallenge(resp.Header.Get("WWW-Authenticate"))
+
+	var authorization string
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(c.Username+":"+c.Password))
+	case "bearer":
+		token, err := c.getToken(ctx, parameters["realm"], parameters["service"], scope)
+		if err != nil {
+			return err
+		}
+		authorization = "Bearer " + token
+	default:
+		return fmt.Errorf("Unsupported authentication scheme %s requested by the registry", scheme)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.authorizations[scope] = authorization
+
+	return nil
+}
+
+// getToken requests a bearer token for the scope from the token server of the registry using the credentials of the client
+func (c *RegistryClient) getToken(ctx context.Context, realm string, service string, scope string) (string, error) {
+
+	if realm == "" {
+		return "", fmt.Errorf("Registry did not advertise the location of its token server")
+	}
+
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+
+	query := tokenURL.Query()
+	if service != "" {
+		query.Set("service", service)
+	}
+	if scope != "" {
+		query.Set("scope", scope)
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := c.newRequest(ctx, http.MethodGet, tokenURL, nil, "")
+	if err != nil {
+		return "", err
+	}
+
+	if c.Username != "" {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+
+	resp, body, err := c.send(req)
+	if err != nil {
+		return "", err
+	}
+
+	if !isSuccessful(resp) {
+		return "", newAPIError(resp, body)
+	}
+
+	token := Token{}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", err
+	}
+
+	switch {
+	case token.Token != "":
+		return token.Token, nil
+	case token.AccessToken != "":
+		return token.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("Token server of the registry did not return a token for scope %s", scope)
+}
+
+// parseChallenge returns the scheme and parameters of a WWW-Authenticate challenge
+func parseChallenge(challenge string) (string, map[string]string) {
+
+	scheme := strings.TrimSpace(challenge)
+	parameters := map[string]string{}
+
+	if i := strings.Index(scheme, " "); i >= 0 {
+		for _, match := range challengeParameterPattern.FindAllStringSubmatch(scheme[i+1:], -1) {
+			parameters[strings.ToLower(match[1])] = match[2]
+		}
+		scheme = scheme[:i]
+	}
+
+	return scheme, parameters
+}
+
+// requestContext returns the context of a request which is bounded by the request timeout of the client unless the caller provided a deadline
+func (c *RegistryClient) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+
+	if _, ok := ctx.Deadline(); ok || c.RequestTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.RequestTimeout)
+}
+
+func isSuccessful(resp *http.Response) bool {
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+}
+
+func NewClient(httpClient *http.Client, baseUrl string, username string, password string) *RegistryClient {
+	registryClient := RegistryClient{
+		httpClient:     httpClient,
+		Username:       username,
+		Password:       password,
+		RequestTimeout: DefaultRequestTimeout,
+		authorizations: map[string]string{},
+	}
+
+	registryClient.BaseURL, _ = url.Parse(baseUrl)
+	return &registryClient
+}
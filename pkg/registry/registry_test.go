@@ -0,0 +1,321 @@
+package registry
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRegistry is the state held by the fake registry server
+type fakeRegistry struct {
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	// tags maps the tags of each repository to the digest of their manifest
+	tags map[string]map[string]string
+	// scopes are the scopes tokens were requested for
+	scopes []string
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+		tags:      map[string]map[string]string{},
+	}
+}
+
+// writeError responds with an error in the format of the registry
+func writeError(w http.ResponseWriter, statusCode int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(APIError{Errors: []ErrorDetail{{Code: code, Message: strings.ToLower(code)}}})
+}
+
+// newRegistryServer returns a server implementing the token authentication, blob, manifest, tag and catalog endpoints of a registry
+func newRegistryServer(registry *fakeRegistry) *httptest.Server {
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Path == "/v2/auth" {
+			username, password, _ := r.BasicAuth()
+			if username != "quay" || password != "password" || r.URL.Query().Get("service") != "quay" {
+				writeError(w, http.StatusUnauthorized, "UNAUTHORIZED")
+				return
+			}
+			scope := r.URL.Query().Get("scope")
+			registry.scopes = append(registry.scopes, scope)
+			json.NewEncoder(w).Encode(Token{Token: "token:" + scope})
+			return
+		}
+
+		// Requests modifying a repository require a token granting the push action
+		authorization := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, "Bearer token:") || (r.Method != http.MethodGet && !strings.HasSuffix(authorization, ",push")) {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/v2/auth",service="quay"`, server.URL))
+			writeError(w, http.StatusUnauthorized, "UNAUTHORIZED")
+			return
+		}
+
+		if r.URL.Path == "/v2/_catalog" {
+			repositories := []string{}
+			for repository := range registry.tags {
+				repositories = append(repositories, repository)
+			}
+			sort.Strings(repositories)
+
+			// Repositories are listed one per page
+			index, _ := strconv.Atoi(r.URL.Query().Get("last"))
+			if index < len(repositories)-1 {
+				w.Header().Set("Link", fmt.Sprintf(`</v2/_catalog?n=1&last=%d>; rel="next"`, index+1))
+			}
+			if index < len(repositories) {
+				repositories = repositories[index : index+1]
+			}
+			json.NewEncoder(w).Encode(Catalog{Repositories: repositories})
+			return
+		}
+
+		path := strings.TrimPrefix(r.URL.Path, "/v2/")
+
+		switch {
+		case strings.HasSuffix(path, "/tags/list"):
+			repository := strings.TrimSuffix(path, "/tags/list")
+			tagList := TagList{Name: repository, Tags: []string{}}
+			for tag := range registry.tags[repository] {
+				tagList.Tags = append(tagList.Tags, tag)
+			}
+			json.NewEncoder(w).Encode(tagList)
+
+		case strings.HasSuffix(path, "/blobs/uploads/") && r.Method == http.MethodPost:
+			w.Header().Set("Location", "/v2/"+path+"upload")
+			w.WriteHeader(http.StatusAccepted)
+
+		case strings.Contains(path, "/blobs/uploads/") && r.Method == http.MethodPut:
+			content, _ := ioutil.ReadAll(r.Body)
+			digest := r.URL.Query().Get("digest")
+			if Digest(content) != digest {
+				writeError(w, http.StatusBadRequest, "DIGEST_INVALID")
+				return
+			}
+			registry.blobs[digest] = content
+			w.WriteHeader(http.StatusCreated)
+
+		case strings.Contains(path, "/blobs/"):
+			content, ok := registry.blobs[path[strings.LastIndex(path, "/")+1:]]
+			if !ok {
+				writeError(w, http.StatusNotFound, "BLOB_UNKNOWN")
+				return
+			}
+			w.Write(content)
+
+		case strings.Contains(path, "/manifests/"):
+			repository := path[:strings.Index(path, "/manifests/")]
+			reference := path[strings.Index(path, "/manifests/")+len("/manifests/"):]
+
+			switch r.Method {
+			case http.MethodPut:
+				content, _ := ioutil.ReadAll(r.Body)
+				manifest := Manifest{}
+				json.Unmarshal(content, &manifest)
+				for _, descriptor := range append([]Descriptor{manifest.Config}, manifest.Layers...) {
+					if _, ok := registry.blobs[descriptor.Digest]; !ok || r.Header.Get("Content-Type") != ManifestMediaType {
+						writeError(w, http.StatusBadRequest, "MANIFEST_INVALID")
+						return
+					}
+				}
+				digest := Digest(content)
+				registry.manifests[digest] = content
+				if registry.tags[repository] == nil {
+					registry.tags[repository] = map[string]string{}
+				}
+				registry.tags[repository][reference] = digest
+				w.Header().Set("Docker-Content-Digest", digest)
+				w.WriteHeader(http.StatusCreated)
+
+			case http.MethodGet:
+				if digest, ok := registry.tags[repository][reference]; ok {
+					reference = digest
+				}
+				content, ok := registry.manifests[reference]
+				if !ok {
+					writeError(w, http.StatusNotFound, "MANIFEST_UNKNOWN")
+					return
+				}
+				w.Header().Set("Content-Type", ManifestMediaType)
+				w.Write(content)
+
+			case http.MethodDelete:
+				delete(registry.manifests, reference)
+				for tag, digest := range registry.tags[repository] {
+					if digest == reference {
+						delete(registry.tags[repository], tag)
+					}
+				}
+				w.WriteHeader(http.StatusAccepted)
+			}
+
+		default:
+			writeError(w, http.StatusNotFound, "NAME_UNKNOWN")
+		}
+	}))
+
+	return server
+}
+
+func TestPushAndPullImage(t *testing.T) {
+
+	registry := newFakeRegistry()
+	server := newRegistryServer(registry)
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL, "quay", "password")
+
+	image, err := NewScratchImage(map[string][]byte{"smoke-test": []byte("content")})
+	assert.NoError(t, err)
+
+	digest, err := client.PushImage(context.TODO(), "example/app", "latest", image)
+
+	assert.NoError(t, err)
+	assert.Equal(t, Digest(registry.manifests[digest]), digest)
+
+	pulledImage, pulledDigest, err := client.PullImage(context.TODO(), "example/app", "latest")
+
+	assert.NoError(t, err)
+	assert.Equal(t, digest, pulledDigest)
+	assert.Equal(t, image, pulledImage)
+
+	tags, err := client.GetTags(context.TODO(), "example/app")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"latest"}, tags)
+
+	err = client.DeleteManifest(context.TODO(), "example/app", digest)
+
+	assert.NoError(t, err)
+
+	_, _, err = client.PullImage(context.TODO(), "example/app", digest)
+
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, fmt.Sprintf("Registry request GET /v2/example/app/manifests/%s failed with status 404 (MANIFEST_UNKNOWN: manifest_unknown)", digest), err.Error())
+
+	// A token is requested once per scope
+	assert.Equal(t, []string{"repository:example/app:pull,push", "repository:example/app:pull"}, registry.scopes)
+}
+
+func TestGetCatalog(t *testing.T) {
+
+	registry := newFakeRegistry()
+	registry.tags = map[string]map[string]string{"example/first": {}, "example/second": {}, "other/third": {}}
+	server := newRegistryServer(registry)
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL, "quay", "password")
+
+	repositories, err := client.GetCatalog(context.TODO())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example/first", "example/second", "other/third"}, repositories)
+	assert.Equal(t, []string{""}, registry.scopes)
+}
+
+func TestRejectedCredentials(t *testing.T) {
+
+	server := newRegistryServer(newFakeRegistry())
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL, "quay", "wrong")
+
+	_, err := client.GetTags(context.TODO(), "example/app")
+
+	assert.True(t, IsUnauthorized(err))
+	assert.Equal(t, "Registry request GET /v2/auth failed with status 401 (UNAUTHORIZED: unauthorized)", err.Error())
+}
+
+func TestBasicChallenge(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if username, password, ok := r.BasicAuth(); !ok || username != "quay" || password != "password" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		json.NewEncoder(w).Encode(TagList{Name: "example/app", Tags: []string{"latest"}})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL, "quay", "password")
+
+	tags, err := client.GetTags(context.TODO(), "example/app")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"latest"}, tags)
+}
+
+func TestBlobsAreVerified(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("tampered"))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client(), server.URL, "quay", "password")
+
+	_, err := client.GetBlob(context.TODO(), "example/app", Digest([]byte("content")))
+
+	assert.Error(t, err)
+}
+
+func TestScratchImage(t *testing.T) {
+
+	image, err := NewScratchImage(map[string][]byte{"b": []byte("second"), "a": []byte("first")})
+	assert.NoError(t, err)
+
+	manifest := image.Manifest()
+
+	assert.Equal(t, ManifestMediaType, manifest.MediaType)
+	assert.Equal(t, Digest(image.Config), manifest.Config.Digest)
+	assert.Len(t, manifest.Layers, 1)
+	assert.Equal(t, int64(len(image.Layers[0])), manifest.Layers[0].Size)
+
+	uncompressed, err := gzip.NewReader(bytes.NewReader(image.Layers[0]))
+	assert.NoError(t, err)
+
+	layer, err := ioutil.ReadAll(uncompressed)
+	assert.NoError(t, err)
+
+	config := ImageConfig{}
+	assert.NoError(t, json.Unmarshal(image.Config, &config))
+	assert.Equal(t, []string{Digest(layer)}, config.RootFS.DiffIDs)
+
+	files := map[string]string{}
+	names := []string{}
+	reader := tar.NewReader(bytes.NewReader(layer))
+	for {
+		header, err := reader.Next()
+		if err != nil {
+			break
+		}
+		content, _ := ioutil.ReadAll(reader)
+		files[header.Name] = string(content)
+		names = append(names, header.Name)
+	}
+
+	assert.Equal(t, []string{"a", "b"}, names)
+	assert.Equal(t, map[string]string{"a": "first", "b": "second"}, files)
+}
@@ -0,0 +1,56 @@
+package registry
+
+const (
+	// ManifestMediaType is the media type of Docker image manifests, schema version 2
+	ManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	// ImageConfigMediaType is the media type of Docker image configurations
+	ImageConfigMediaType = "application/vnd.docker.container.image.v1+json"
+	// LayerMediaType is the media type of gzip compressed layers
+	LayerMediaType = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+)
+
+// Descriptor references content stored in the registry by its digest
+type Descriptor struct {
+	MediaType string `json:"mediaType"`
+	Size      int64  `json:"size"`
+	Digest    string `json:"digest"`
+}
+
+// Manifest is a Docker image manifest, schema version 2
+type Manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType"`
+	Config        Descriptor   `json:"config"`
+	Layers        []Descriptor `json:"layers"`
+}
+
+// ImageConfig is the configuration of an image which is stored as a blob
+type ImageConfig struct {
+	Architecture string      `json:"architecture"`
+	OS           string      `json:"os"`
+	Created      string      `json:"created,omitempty"`
+	RootFS       ImageRootFS `json:"rootfs"`
+}
+
+// ImageRootFS references the uncompressed layers of an image
+type ImageRootFS struct {
+	Type    string   `json:"type"`
+	DiffIDs []string `json:"diff_ids"`
+}
+
+// Catalog is a page of the repositories of the registry
+type Catalog struct {
+	Repositories []string `json:"repositories"`
+}
+
+// TagList is a page of the tags of a repository
+type TagList struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// Token is returned by the token server of the registry
+type Token struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
@@ -0,0 +1,10 @@
+package webhook
+
+import (
+	"github.com/redhat-cop/quay-operator/pkg/webhook/quayecosystem"
+)
+
+func init() {
+	// AddToManagerFuncs is a list of functions to create webhooks and add them to a manager.
+	AddToManagerFuncs = append(AddToManagerFuncs, quayecosystem.Add)
+}
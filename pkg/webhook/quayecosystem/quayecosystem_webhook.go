@@ -0,0 +1,105 @@
+package quayecosystem
+
+import (
+	"context"
+	"net/http"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/resources"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/validation"
+	"github.com/redhat-cop/quay-operator/pkg/k8sutils"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+const (
+	// ValidatingWebhookPath is the path the QuayEcosystem validating webhook is served at
+	ValidatingWebhookPath = "/validate-redhatcop-redhat-io-v1alpha1-quayecosystem"
+
+	// ConversionWebhookPath is the path the QuayEcosystem conversion webhook is served at
+	ConversionWebhookPath = "/convert"
+)
+
+var log = logf.Log.WithName("webhook_quayecosystem")
+
+// Add registers the QuayEcosystem Webhooks with the Manager's Webhook Server
+func Add(mgr manager.Manager) error {
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+
+	if err != nil {
+		return err
+	}
+
+	mgr.GetWebhookServer().Register(ValidatingWebhookPath, &webhook.Admission{Handler: &QuayEcosystemValidator{isOpenShift: k8sutils.IsOpenShift(discoveryClient)}})
+	mgr.GetWebhookServer().Register(ConversionWebhookPath, &conversion.Webhook{})
+
+	return nil
+}
+
+// blank assignment to verify that QuayEcosystemValidator implements admission.Handler
+var _ admission.Handler = &QuayEcosystemValidator{}
+
+// QuayEcosystemValidator validates QuayEcosystem create and update requests
+type QuayEcosystemValidator struct {
+	decoder     *admission.Decoder
+	isOpenShift bool
+}
+
+// Handle admits a QuayEcosystem when its specification is valid
+// Referenced Secrets are not validated as they may be created after the QuayEcosystem and may not be readable
+// through the cache of the operator, so they are left to the controller
+func (v *QuayEcosystemValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{}
+
+	err := v.decoder.Decode(req, quayEcosystem)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.Operation == admissionv1beta1.Update {
+
+		oldQuayEcosystem := &redhatcopv1alpha1.QuayEcosystem{}
+
+		err := v.decoder.DecodeRaw(req.OldObject, oldQuayEcosystem)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		err = validation.ValidateUpdate(oldQuayEcosystem, quayEcosystem)
+		if err != nil {
+			log.Info("Rejecting QuayEcosystem update", "Namespace", quayEcosystem.Namespace, "Name", quayEcosystem.Name, "Reason", err.Error())
+			return admission.Denied(err.Error())
+		}
+	}
+
+	// Validate against a copy so that defaults are not persisted by the webhook
+	quayConfiguration := resources.QuayConfiguration{
+		QuayEcosystem:              quayEcosystem.DeepCopy(),
+		IsOpenShift:                v.isOpenShift,
+		RequiredSCCServiceAccounts: []string{constants.QuayServiceAccount},
+	}
+
+	validation.SetDefaults(nil, &quayConfiguration)
+
+	err = validation.ValidateSpec(&quayConfiguration)
+	if err != nil {
+		log.Info("Rejecting QuayEcosystem", "Namespace", quayEcosystem.Namespace, "Name", quayEcosystem.Name, "Reason", err.Error())
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// InjectDecoder injects the decoder into the QuayEcosystemValidator
+func (v *QuayEcosystemValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
@@ -0,0 +1,132 @@
+package quayecosystem
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var name = "quay-operator"
+var namespace = "quay-enterprise"
+
+func newValidator(t *testing.T) *QuayEcosystemValidator {
+
+	// Register operator types with the runtime scheme.
+	s := scheme.Scheme
+	s.AddKnownTypes(redhatcopv1alpha1.SchemeGroupVersion, &redhatcopv1alpha1.QuayEcosystem{})
+
+	decoder, err := admission.NewDecoder(s)
+	if err != nil {
+		t.Fatal(err, "")
+	}
+
+	validator := &QuayEcosystemValidator{isOpenShift: true}
+	assert.NoError(t, validator.InjectDecoder(decoder))
+
+	return validator
+}
+
+func newRequest(t *testing.T, operation admissionv1beta1.Operation, oldQuayEcosystem *redhatcopv1alpha1.QuayEcosystem, newQuayEcosystem *redhatcopv1alpha1.QuayEcosystem) admission.Request {
+
+	req := admission.Request{
+		AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Operation: operation,
+		},
+	}
+
+	raw, err := json.Marshal(newQuayEcosystem)
+	assert.NoError(t, err)
+	req.Object.Raw = raw
+
+	if oldQuayEcosystem != nil {
+		raw, err := json.Marshal(oldQuayEcosystem)
+		assert.NoError(t, err)
+		req.OldObject.Raw = raw
+	}
+
+	return req
+}
+
+func newQuayEcosystem() *redhatcopv1alpha1.QuayEcosystem {
+	return &redhatcopv1alpha1.QuayEcosystem{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "QuayEcosystem",
+			APIVersion: "redhatcop.redhat.io/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+
+	validator := newValidator(t)
+
+	quayEcosystem := newQuayEcosystem()
+
+	response := validator.Handle(context.TODO(), newRequest(t, admissionv1beta1.Create, nil, quayEcosystem))
+
+	assert.True(t, response.Allowed)
+}
+
+func TestValidateCreateMissingSecret(t *testing.T) {
+
+	validator := newValidator(t)
+
+	// Secrets may be created after the QuayEcosystem so their absence is left to the controller
+	quayEcosystem := newQuayEcosystem()
+	quayEcosystem.Spec.Quay = &redhatcopv1alpha1.Quay{
+		SuperuserCredentialsSecretName: "missing-superuser-secret",
+	}
+
+	response := validator.Handle(context.TODO(), newRequest(t, admissionv1beta1.Create, nil, quayEcosystem))
+
+	assert.True(t, response.Allowed)
+}
+
+func TestValidateCreateInvalidSpec(t *testing.T) {
+
+	validator := newValidator(t)
+
+	quayEcosystem := newQuayEcosystem()
+	quayEcosystem.Spec.Quay = &redhatcopv1alpha1.Quay{
+		ExternalAccess: &redhatcopv1alpha1.ExternalAccess{
+			Type: redhatcopv1alpha1.NodePortExternalAccessType,
+		},
+	}
+
+	response := validator.Handle(context.TODO(), newRequest(t, admissionv1beta1.Create, nil, quayEcosystem))
+
+	assert.False(t, response.Allowed)
+	assert.Contains(t, string(response.Result.Reason), "Without Hostname Defined")
+}
+
+func TestValidateUpdateImmutableDatabaseServer(t *testing.T) {
+
+	validator := newValidator(t)
+
+	oldQuayEcosystem := newQuayEcosystem()
+	oldQuayEcosystem.Spec.Quay = &redhatcopv1alpha1.Quay{
+		Database: &redhatcopv1alpha1.Database{
+			Server: "postgresql-old",
+		},
+	}
+	oldQuayEcosystem.Status.SetupComplete = true
+
+	newQuayEcosystem := oldQuayEcosystem.DeepCopy()
+	newQuayEcosystem.Spec.Quay.Database.Server = "postgresql-new"
+
+	response := validator.Handle(context.TODO(), newRequest(t, admissionv1beta1.Update, oldQuayEcosystem, newQuayEcosystem))
+
+	assert.False(t, response.Allowed)
+	assert.Contains(t, string(response.Result.Reason), "cannot be modified")
+}
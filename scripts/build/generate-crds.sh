@@ -16,12 +16,45 @@ if ! command -v yq > /dev/null 2>&1; then
   exit 1
 fi
 
+if ! command -v controller-gen > /dev/null 2>&1; then
+  echo controller-gen is not available
+  exit 1
+fi
+
 set -e
 
 operator-sdk generate k8s
 operator-sdk generate openapi
 
-cp -f "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" "deploy/crds/redhatcop.redhat.io_quayecosystems_crd-3.x.yaml"
+CRD_DIR=$(mktemp -d)
+
+# Each served version requires its own schema for conversion to be performed by the webhook
+controller-gen crd paths=./pkg/apis/... output:crd:dir="${CRD_DIR}"
+mv "${CRD_DIR}/redhatcop.redhat.io_quayecosystems.yaml" "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml"
+rm -f "${CRD_DIR}"/*.yaml
+
+yq w -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" 'metadata.annotations."service.beta.openshift.io/inject-cabundle"' --tag '!!str' true
+yq w -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" spec.preserveUnknownFields false
+yq w -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" spec.conversion.strategy Webhook
+yq w -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" spec.conversion.conversionReviewVersions[+] v1beta1
+yq w -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" spec.conversion.webhookClientConfig.service.name quay-operator-webhook
+yq w -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" spec.conversion.webhookClientConfig.service.namespace quay-enterprise
+yq w -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" spec.conversion.webhookClientConfig.service.path /convert
+yq d -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd.yaml" status
+
+# OpenShift 3.x does not support conversion webhooks so only v1alpha1 is served
+controller-gen crd:trivialVersions=true paths=./pkg/apis/redhatcop/v1alpha1/... output:crd:dir="${CRD_DIR}"
+mv "${CRD_DIR}/redhatcop.redhat.io_quayecosystems.yaml" "deploy/crds/redhatcop.redhat.io_quayecosystems_crd-3.x.yaml"
+yq d -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd-3.x.yaml" status
 
 # Remove Invalid Property
-yq d -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd-3.x.yaml" spec.validation.openAPIV3Schema.type
\ No newline at end of file
+yq d -i "deploy/crds/redhatcop.redhat.io_quayecosystems_crd-3.x.yaml" spec.validation.openAPIV3Schema.type
+
+# Resources managed through the Quay API only exist in v1alpha1
+for crd in "${CRD_DIR}"/*.yaml; do
+  target="deploy/crds/$(basename "${crd}" .yaml)_crd.yaml"
+  mv "${crd}" "${target}"
+  yq d -i "${target}" status
+done
+
+rm -rf "${CRD_DIR}"
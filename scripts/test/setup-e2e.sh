@@ -11,4 +11,12 @@ oc new-project $QUAY_NAMESPACE
 oc create serviceaccount quay
 oc adm policy add-cluster-role-to-user cluster-admin admin
 oc login -u admin -p admin
-oc apply -f ./deploy/crds/redhatcop.redhat.io_quayecosystems_crd-3.x.yaml
\ No newline at end of file
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quayecosystems_crd-3.x.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quayorganizations_crd.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quayrepositories_crd.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quayrobotaccounts_crd.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quayteams_crd.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quayrepositorymirrors_crd.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quaytagretentionpolicies_crd.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quaynotifications_crd.yaml
+oc apply -f ./deploy/crds/redhatcop.redhat.io_quayusers_crd.yaml
\ No newline at end of file
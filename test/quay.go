@@ -0,0 +1,141 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-cop/operator-utils/pkg/util"
+	redhatcopv1alpha1 "github.com/redhat-cop/quay-operator/pkg/apis/redhatcop/v1alpha1"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayapi"
+	"github.com/redhat-cop/quay-operator/pkg/controller/quayecosystem/constants"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// QuayEcosystemName is the name of the QuayEcosystem referenced by the resources of the controller tests
+const QuayEcosystemName = "example-quayecosystem"
+
+// QuayAPIToken is the API token minted for the QuayEcosystem referenced by the resources of the controller tests
+const QuayAPIToken = "example-token"
+
+// quayAPITokenSecretName is the name of the Secret containing the API token of the QuayEcosystem
+const quayAPITokenSecretName = QuayEcosystemName + "-quay-api-token"
+
+// NewQuayServer returns a server for the provided Quay API handler which rejects requests that are not authenticated with the API token
+func NewQuayServer(handler http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Header.Get("Authorization") != "Bearer "+QuayAPIToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error_message": "Unauthorized"}`))
+			return
+		}
+
+		handler(w, r)
+	}))
+}
+
+// NewReadyQuayEcosystem returns a QuayEcosystem whose setup completed and whose Quay is served by the provided server
+// Its API token is contained in the Secret returned by NewQuayAPITokenSecret
+// The effective specification of Quay can be adjusted by the provided functions
+func NewReadyQuayEcosystem(t *testing.T, namespace string, server *httptest.Server, modifiers ...func(*redhatcopv1alpha1.Quay)) *redhatcopv1alpha1.QuayEcosystem {
+
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	quayEcosystem := &redhatcopv1alpha1.QuayEcosystem{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      QuayEcosystemName,
+			Namespace: namespace,
+		},
+		Status: redhatcopv1alpha1.QuayEcosystemStatus{
+			SetupComplete: true,
+			Hostname:      serverURL.Host,
+			APIToken: &redhatcopv1alpha1.APITokenStatus{
+				SecretName: quayAPITokenSecretName,
+			},
+		},
+	}
+
+	quay := &redhatcopv1alpha1.Quay{
+		ExternalAccess: &redhatcopv1alpha1.ExternalAccess{
+			TLS: &redhatcopv1alpha1.TLSExternalAccess{
+				Termination: redhatcopv1alpha1.NoneTLSTerminationType,
+			},
+		},
+	}
+
+	for _, modify := range modifiers {
+		modify(quay)
+	}
+
+	_, err = quayEcosystem.SetEffectiveSpec(&redhatcopv1alpha1.QuayEcosystemSpec{Quay: quay})
+	assert.NoError(t, err)
+
+	return quayEcosystem
+}
+
+// NewQuayAPITokenSecret returns the Secret containing the API token of the QuayEcosystem returned by NewReadyQuayEcosystem
+func NewQuayAPITokenSecret(namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      quayAPITokenSecretName,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			constants.QuayAPITokenSecretKey: []byte(QuayAPIToken),
+		},
+	}
+}
+
+// NewReconcilerBase returns a ReconcilerBase whose client is a fake client containing the provided objects
+func NewReconcilerBase(objs ...runtime.Object) util.ReconcilerBase {
+
+	s := scheme.Scheme
+
+	if err := redhatcopv1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		panic(err)
+	}
+
+	return util.NewReconcilerBase(fake.NewFakeClientWithScheme(s, objs...), s, nil, record.NewFakeRecorder(10))
+}
+
+// NewResourceReconciler returns a ResourceReconciler whose client is a fake client containing the provided objects
+func NewResourceReconciler(objs ...runtime.Object) quayapi.ResourceReconciler {
+	return quayapi.ResourceReconciler{ReconcilerBase: NewReconcilerBase(objs...)}
+}
+
+// ReconcileResource reconciles the resource and then replaces it with its state after the reconciliation
+// The resource is left empty when it no longer exists
+func ReconcileResource(r reconcile.Reconciler, c client.Client, resource runtime.Object) (reconcile.Result, error) {
+
+	accessor, err := meta.Accessor(resource)
+
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	key := types.NamespacedName{Namespace: accessor.GetNamespace(), Name: accessor.GetName()}
+
+	result, err := r.Reconcile(reconcile.Request{NamespacedName: key})
+
+	// Fields removed by the reconciliation must not be retained from the previous state
+	reflect.ValueOf(resource).Elem().Set(reflect.Zero(reflect.TypeOf(resource).Elem()))
+
+	c.Get(context.TODO(), key, resource)
+
+	return result, err
+}